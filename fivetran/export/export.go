@@ -0,0 +1,221 @@
+// Package export implements the `export` command of the provider binary. The command reads the resources of an existing
+// Fivetran account and writes the Terraform configuration managing them, together with the `import {}` blocks that bring
+// the existing resources under Terraform management.
+package export
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/common"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Run runs the export command with the command line arguments following `export`.
+func Run(args []string) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	out := flags.String("out", ".", "directory the generated configuration is written to")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: terraform-provider-fivetran export [-out directory]")
+		fmt.Fprintln(flags.Output(), "Exports the account of the FIVETRAN_APIKEY and FIVETRAN_APISECRET credentials (FIVETRAN_API_URL is optional).")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+
+	apiKey := os.Getenv("FIVETRAN_APIKEY")
+	apiSecret := os.Getenv("FIVETRAN_APISECRET")
+	if apiKey == "" || apiSecret == "" {
+		return fmt.Errorf("FIVETRAN_APIKEY and FIVETRAN_APISECRET environment variables should be set.")
+	}
+
+	common.LoadConfigFieldsMap()
+	common.LoadAuthFieldsMap()
+	common.LoadDestinationFieldsMap()
+	common.LoadExternalLoggingFieldsMap()
+
+	client := fivetran.New(apiKey, apiSecret)
+	if apiUrl := os.Getenv("FIVETRAN_API_URL"); apiUrl != "" {
+		client.BaseURL(apiUrl)
+	}
+	client.CustomUserAgent("terraform-provider-fivetran/" + framework.Version)
+
+	warnings, err := Export(context.Background(), client, *out)
+	for _, warning := range warnings {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", warning)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Configuration written to %v, review it and run `terraform plan` to import the resources.\n", *out)
+	return nil
+}
+
+// Export writes the configuration of all groups, destinations, connections, users, teams, memberships, webhooks and
+// transformations of the account into the dir. Failures to read details of single resources don't stop the export,
+// they are returned as warnings and the affected part of the configuration is skipped.
+func Export(ctx context.Context, client *fivetran.Client, dir string) ([]string, error) {
+	e := &exporter{
+		ctx:       ctx,
+		client:    client,
+		files:     map[string]*hclwrite.File{},
+		names:     map[string]bool{},
+		addresses: map[string][]string{},
+		groups:    map[string]string{},
+		schemas:   map[string][]byte{},
+	}
+
+	for _, step := range []func() error{
+		e.exportGroups,
+		e.exportDestinations,
+		e.exportConnections,
+		e.exportUsers,
+		e.exportTeams,
+		e.exportMemberships,
+		e.exportWebhooks,
+		e.exportTransformations,
+	} {
+		if err := step(); err != nil {
+			return e.warnings, err
+		}
+	}
+	return e.warnings, e.write(dir)
+}
+
+type exporter struct {
+	ctx    context.Context
+	client *fivetran.Client
+
+	// files holds the configuration files by file name
+	files map[string]*hclwrite.File
+	// names holds the taken `<resource type>.<name>` addresses and `var.<name>` variables
+	names map[string]bool
+	// addresses maps `<kind>:<id>` of the exported resources to their resource type and name, so other resources reference them
+	addresses map[string][]string
+	// schemas holds the schema config JSON files by file name
+	schemas map[string][]byte
+	// groups maps the group ids to the group resource names, the resources of a group are named after it
+	groups map[string]string
+	// users and teams are the exported users and teams, their memberships are exported after all other resources
+	users    []exported
+	teams    []exported
+	warnings []string
+}
+
+func (e *exporter) warn(format string, args ...any) {
+	e.warnings = append(e.warnings, fmt.Sprintf(format, args...))
+}
+
+func (e *exporter) body(file string) *hclwrite.Body {
+	f, ok := e.files[file]
+	if !ok {
+		f = hclwrite.NewEmptyFile()
+		e.files[file] = f
+	}
+	return f.Body()
+}
+
+func (e *exporter) uniqueName(namespace, base string) string {
+	name := resourceName(base)
+	candidate := name
+	for i := 2; e.names[namespace+"."+candidate]; i++ {
+		candidate = fmt.Sprintf("%v_%v", name, i)
+	}
+	e.names[namespace+"."+candidate] = true
+	return candidate
+}
+
+// resource appends the resource block to the file and the import block of the resource to `imports.tf`.
+// The address of the resource is registered under the kind and id, if the kind is set.
+func (e *exporter) resource(file, resourceType, name, kind, importId string) (*hclwrite.Body, string) {
+	name = e.uniqueName(resourceType, name)
+
+	body := e.body(file)
+	block := body.AppendNewBlock("resource", []string{resourceType, name})
+	body.AppendNewline()
+
+	imports := e.body("imports.tf")
+	importBlock := imports.AppendNewBlock("import", nil).Body()
+	importBlock.SetAttributeRaw("to", traversalTokens(resourceType, name))
+	importBlock.SetAttributeValue("id", cty.StringVal(importId))
+	imports.AppendNewline()
+
+	if kind != "" {
+		e.addresses[kind+":"+importId] = []string{resourceType, name}
+	}
+	return block.Body(), name
+}
+
+// reference returns the `id` reference of the exported resource, or the id itself if the resource isn't exported.
+func (e *exporter) reference(kind, id string) hclwrite.Tokens {
+	if address, ok := e.addresses[kind+":"+id]; ok {
+		return traversalTokens(address[0], address[1], "id")
+	}
+	return stringTokens(id)
+}
+
+func (e *exporter) references(kind string, ids []string) hclwrite.Tokens {
+	elements := make([]hclwrite.Tokens, 0, len(ids))
+	for _, id := range ids {
+		elements = append(elements, e.reference(kind, id))
+	}
+	return hclwrite.TokensForTuple(elements)
+}
+
+// secret declares a sensitive variable for a secret the API doesn't return and returns the reference to it.
+func (e *exporter) secret(name string) hclwrite.Tokens {
+	name = e.uniqueName("var", name)
+
+	body := e.body("variables.tf")
+	variable := body.AppendNewBlock("variable", []string{name}).Body()
+	variable.SetAttributeRaw("type", traversalTokens("string"))
+	variable.SetAttributeValue("sensitive", cty.True)
+	body.AppendNewline()
+
+	return traversalTokens("var", name)
+}
+
+func (e *exporter) write(dir string) error {
+	providers := e.body("versions.tf")
+	terraform := providers.AppendNewBlock("terraform", nil).Body()
+	// import blocks are supported since Terraform 1.5
+	terraform.SetAttributeValue("required_version", cty.StringVal(">= 1.5.0"))
+	terraform.AppendNewBlock("required_providers", nil).Body().SetAttributeValue("fivetran", cty.ObjectVal(map[string]cty.Value{
+		"source": cty.StringVal("fivetran/fivetran"),
+	}))
+	providers.AppendNewline()
+	providers.AppendNewBlock("provider", []string{"fivetran"})
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	for _, name := range sortedKeys(e.files) {
+		content := append(bytes.TrimRight(hclwrite.Format(e.files[name].Bytes()), "\n"), '\n')
+		if err := os.WriteFile(filepath.Join(dir, name), content, 0644); err != nil {
+			return err
+		}
+	}
+	if len(e.schemas) > 0 {
+		if err := os.MkdirAll(filepath.Join(dir, "schemas"), 0755); err != nil {
+			return err
+		}
+	}
+	for _, name := range sortedKeys(e.schemas) {
+		if err := os.WriteFile(filepath.Join(dir, "schemas", name), e.schemas[name], 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package export
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/common"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+type routesHTTPClient struct {
+	routes map[string]string
+}
+
+func (c routesHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	body, ok := c.routes[req.Method+" "+req.URL.Path]
+	status := http.StatusOK
+	if !ok {
+		body = `{"code":"NotFound","message":"unexpected request"}`
+		status = http.StatusNotFound
+	}
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}, nil
+}
+
+const emptyList = `{"code":"Success","data":{"items":[]}}`
+
+func TestExport(t *testing.T) {
+	common.LoadConfigFieldsMap()
+	common.LoadAuthFieldsMap()
+	common.LoadDestinationFieldsMap()
+	common.LoadExternalLoggingFieldsMap()
+
+	client := fivetran.New("key", "secret")
+	client.SetHttpClient(routesHTTPClient{routes: map[string]string{
+		"GET /v1/groups":       `{"code":"Success","data":{"items":[{"id":"group_id","name":"Analytics Warehouse"}]}}`,
+		"GET /v1/destinations": `{"code":"Success","data":{"items":[{"id":"group_id","group_id":"group_id","service":"snowflake"}]}}`,
+		"GET /v1/destinations/group_id": `{"code":"Success","data":{"id":"group_id","group_id":"group_id","service":"snowflake","region":"GCP_US_EAST4",
+			"time_zone_offset":"0","config":{"host":"account.snowflakecomputing.com","port":443,"user":"fivetran","password":"******"}}}`,
+		"GET /v1/connections": `{"code":"Success","data":{"items":[
+			{"id":"connection_id","group_id":"group_id","service":"postgres","schema":"pg"},
+			{"id":"other_connection_id","group_id":"group_id","service":"postgres","schema":"pg-2"}]}}`,
+		"GET /v1/connections/connection_id": `{"code":"Success","data":{"id":"connection_id","group_id":"group_id","service":"postgres","schema":"pg",
+			"config":{"schema_prefix":"pg","host":"db.example.com","port":5432,"user":"fivetran","password":"******","update_method":"XMIN",
+			"latest_version":"3","always_encrypted":null}}}`,
+		"GET /v1/connections/connection_id/schemas": `{"code":"Success","data":{"schema_change_handling":"ALLOW_COLUMNS","schemas":{
+			"public":{"name_in_destination":"public","enabled":true,"tables":{"orders":{"enabled":true,"sync_mode":"SOFT_DELETE",
+			"columns":{"email":{"enabled":true,"hashed":true}}}}}}}}`,
+		"GET /v1/users":                     `{"code":"Success","data":{"items":[{"id":"user_id","email":"john@example.com","given_name":"John","role":"Account Administrator"}]}}`,
+		"GET /v1/users/user_id/connections": emptyList,
+		"GET /v1/users/user_id/groups":      `{"code":"Success","data":{"items":[{"id":"group_id","role":"Destination Administrator"}]}}`,
+		"GET /v1/teams":                     `{"code":"Success","data":{"items":[{"id":"team_id","name":"Data Engineers","role":"Account Reviewer"}]}}`,
+		"GET /v1/teams/team_id/connections": `{"code":"Success","data":{"items":[{"id":"connection_id","role":"Connector Administrator"}]}}`,
+		"GET /v1/teams/team_id/groups":      emptyList,
+		"GET /v1/teams/team_id/users":       `{"code":"Success","data":{"items":[{"user_id":"user_id","role":"Team Member"}]}}`,
+		"GET /v1/webhooks":                  `{"code":"Success","data":{"items":[{"id":"webhook_id","type":"group","group_id":"group_id","url":"https://example.com","events":["sync_end"],"active":true,"secret":"******"}]}}`,
+		"GET /v1/transformation-projects":   `{"code":"Success","data":{"items":[{"id":"project_id","type":"DBT_GIT","group_id":"group_id"}]}}`,
+		"GET /v1/transformation-projects/project_id": `{"code":"Success","data":{"id":"project_id","type":"DBT_GIT","group_id":"group_id",
+			"project_config":{"dbt_version":"1.0.1","git_remote_url":"git@github.com:example/dbt.git","git_branch":"main","threads":4}}}`,
+		"GET /v1/transformations": `{"code":"Success","data":{"items":[{"id":"transformation_id","type":"DBT_CORE","paused":false,
+			"schedule":{"schedule_type":"INTEGRATED","connection_ids":["connection_id"]},
+			"transformation_config":{"project_id":"project_id","name":"daily run","steps":[{"name":"run","command":"dbt run"}]}}]}}`,
+	}})
+
+	dir := t.TempDir()
+	warnings, err := Export(context.Background(), client, dir)
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	// the other connection has neither details nor schema config
+	if len(warnings) != 2 || !strings.Contains(warnings[0], "other_connection_id") {
+		t.Errorf("Export() warnings = %v", warnings)
+	}
+
+	files := map[string]string{}
+	entries, _ := os.ReadDir(dir)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		content, _ := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if _, diags := hclsyntax.ParseConfig(content, entry.Name(), hcl.InitialPos); diags.HasErrors() {
+			t.Errorf("%v isn't valid HCL: %v", entry.Name(), diags)
+		}
+		files[entry.Name()] = string(content)
+	}
+
+	for file, expected := range map[string][]string{
+		"groups.tf": {`resource "fivetran_group" "analytics_warehouse" {`},
+		"destinations.tf": {
+			`group_id                     = fivetran_group.analytics_warehouse.id`,
+			`port     = 443`,
+			`password = var.analytics_warehouse_password`,
+		},
+		"connections.tf": {
+			`resource "fivetran_connection" "analytics_warehouse_pg" {`,
+			`resource "fivetran_connection" "analytics_warehouse_pg_2" {`,
+			`prefix = "pg"`,
+			`connection_id = fivetran_connection.analytics_warehouse_pg.id`,
+			`password      = var.analytics_warehouse_pg_password`,
+			`update_method = "XMIN"`,
+			`schemas_json           = file("${path.module}/schemas/analytics_warehouse_pg.json")`,
+		},
+		"memberships.tf": {
+			`resource "fivetran_team_connector_membership" "data_engineers" {`,
+			`connector_id = fivetran_connection.analytics_warehouse_pg.id`,
+			`user_id = fivetran_user.john_example_com.id`,
+			`resource "fivetran_user_group_membership" "john_example_com" {`,
+		},
+		"webhooks.tf": {`secret   = var.analytics_warehouse_secret`},
+		"transformations.tf": {
+			`project_id = fivetran_transformation_project.analytics_warehouse.id`,
+			`connection_ids = [fivetran_connection.analytics_warehouse_pg.id]`,
+			`command = "dbt run"`,
+		},
+		"imports.tf":   {"to = fivetran_connector_schema_config.analytics_warehouse_pg\n  id = \"connection_id\""},
+		"variables.tf": {`variable "analytics_warehouse_pg_password" {`},
+		"versions.tf":  {`source = "fivetran/fivetran"`},
+	} {
+		for _, e := range expected {
+			if !strings.Contains(files[file], e) {
+				t.Errorf("%v should contain %v:\n%v", file, e, files[file])
+			}
+		}
+	}
+
+	for file, unexpected := range map[string]string{
+		"connections.tf":  "latest_version",
+		"memberships.tf":  "fivetran_team_group_membership",
+		"destinations.tf": `"******"`,
+	} {
+		if strings.Contains(files[file], unexpected) {
+			t.Errorf("%v shouldn't contain %v:\n%v", file, unexpected, files[file])
+		}
+	}
+
+	schema, _ := os.ReadFile(filepath.Join(dir, "schemas", "analytics_warehouse_pg.json"))
+	if !strings.Contains(string(schema), `"hashed": true`) || strings.Contains(string(schema), "name_in_destination") {
+		t.Errorf("unexpected schema config:\n%v", schema)
+	}
+}
+
+func TestResourceName(t *testing.T) {
+	t.Parallel()
+
+	for value, expected := range map[string]string{
+		"Analytics Warehouse": "analytics_warehouse",
+		"john@example.com":    "john_example_com",
+		"1password":           "_1password",
+		"--":                  "unnamed",
+	} {
+		if actual := resourceName(value); actual != expected {
+			t.Errorf("resourceName(%v) = %v, want %v", value, actual, expected)
+		}
+	}
+}
@@ -0,0 +1,256 @@
+package export
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/common"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// maskedSecret is the value the API returns instead of secrets.
+const maskedSecret = "******"
+
+// resourceName converts the given value into a valid lower snake case resource name.
+func resourceName(value string) string {
+	var builder strings.Builder
+	underscore := false
+	for _, r := range strings.ToLower(value) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			builder.WriteRune(r)
+			underscore = false
+		} else if !underscore && builder.Len() > 0 {
+			builder.WriteRune('_')
+			underscore = true
+		}
+	}
+	name := strings.TrimSuffix(builder.String(), "_")
+	if name == "" {
+		return "unnamed"
+	}
+	if name[0] >= '0' && name[0] <= '9' {
+		return "_" + name
+	}
+	return name
+}
+
+func sortedKeys[V any](values map[string]V) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func traversalTokens(names ...string) hclwrite.Tokens {
+	traversal := hcl.Traversal{hcl.TraverseRoot{Name: names[0]}}
+	for _, name := range names[1:] {
+		traversal = append(traversal, hcl.TraverseAttr{Name: name})
+	}
+	return hclwrite.TokensForTraversal(traversal)
+}
+
+func stringTokens(value string) hclwrite.Tokens {
+	return hclwrite.TokensForValue(cty.StringVal(value))
+}
+
+func stringsTokens(values []string) hclwrite.Tokens {
+	elements := make([]hclwrite.Tokens, 0, len(values))
+	for _, value := range values {
+		elements = append(elements, stringTokens(value))
+	}
+	return hclwrite.TokensForTuple(elements)
+}
+
+// modulePathTokens returns the `"${path.module}/<relativePath>"` template.
+func modulePathTokens(relativePath string) hclwrite.Tokens {
+	return hclwrite.Tokens{
+		{Type: hclsyntax.TokenOQuote, Bytes: []byte(`"`)},
+		{Type: hclsyntax.TokenTemplateInterp, Bytes: []byte("${")},
+		{Type: hclsyntax.TokenIdent, Bytes: []byte("path")},
+		{Type: hclsyntax.TokenDot, Bytes: []byte(".")},
+		{Type: hclsyntax.TokenIdent, Bytes: []byte("module")},
+		{Type: hclsyntax.TokenTemplateSeqEnd, Bytes: []byte("}")},
+		{Type: hclsyntax.TokenQuotedLit, Bytes: []byte("/" + relativePath)},
+		{Type: hclsyntax.TokenCQuote, Bytes: []byte(`"`)},
+	}
+}
+
+// objectTokens writes an object with the given keys in order, keys which aren't valid identifiers are quoted.
+func objectTokens(keys []string, value func(key string) hclwrite.Tokens) hclwrite.Tokens {
+	attrs := make([]hclwrite.ObjectAttrTokens, 0, len(keys))
+	for _, key := range keys {
+		name := hclwrite.TokensForIdentifier(key)
+		if !hclsyntax.ValidIdentifier(key) {
+			name = stringTokens(key)
+		}
+		attrs = append(attrs, hclwrite.ObjectAttrTokens{Name: name, Value: value(key)})
+	}
+	return hclwrite.TokensForObject(attrs)
+}
+
+func stringValue(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return fmt.Sprint(value)
+}
+
+// jsonTokens converts a value decoded from the API JSON into HCL, masked secrets are replaced by variables named
+// after the path of the value.
+func (e *exporter) jsonTokens(value any, variable string, sensitive bool) hclwrite.Tokens {
+	switch v := value.(type) {
+	case map[string]any:
+		keys := []string{}
+		for _, key := range sortedKeys(v) {
+			if v[key] != nil {
+				keys = append(keys, key)
+			}
+		}
+		return objectTokens(keys, func(key string) hclwrite.Tokens {
+			return e.jsonTokens(v[key], variable+"_"+key, false)
+		})
+	case []any:
+		elements := make([]hclwrite.Tokens, 0, len(v))
+		for i, item := range v {
+			elements = append(elements, e.jsonTokens(item, fmt.Sprintf("%v_%v", variable, i), sensitive))
+		}
+		return hclwrite.TokensForTuple(elements)
+	case string:
+		if v == maskedSecret || (sensitive && v != "") {
+			return e.secret(variable)
+		}
+		return stringTokens(v)
+	case float64:
+		return hclwrite.TokensForValue(cty.NumberFloatVal(v))
+	case bool:
+		return hclwrite.TokensForValue(cty.BoolVal(v))
+	}
+	return hclwrite.TokensForValue(cty.NullVal(cty.DynamicPseudoType))
+}
+
+// configFieldTokens converts a config value into the HCL value of the typed config field, nil is returned for values
+// which can't be converted.
+func (e *exporter) configFieldTokens(field common.ConfigField, value any, service, variable string) hclwrite.Tokens {
+	if s, ok := value.(string); ok && (s == maskedSecret || (field.GetIsSensitive(service) && s != "")) {
+		return e.secret(variable)
+	}
+
+	switch field.FieldValueType {
+	case common.String:
+		return stringTokens(stringValue(value))
+	case common.Integer, common.Float:
+		number, err := strconv.ParseFloat(stringValue(value), 64)
+		if err != nil {
+			return nil
+		}
+		return hclwrite.TokensForValue(cty.NumberFloatVal(number))
+	case common.Boolean:
+		b, err := strconv.ParseBool(stringValue(value))
+		if err != nil {
+			return nil
+		}
+		return hclwrite.TokensForValue(cty.BoolVal(b))
+	case common.StringList:
+		items, ok := value.([]any)
+		if !ok {
+			return nil
+		}
+		values := make([]string, 0, len(items))
+		for _, item := range items {
+			values = append(values, stringValue(item))
+		}
+		return stringsTokens(values)
+	case common.Object:
+		item, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		return e.configObjectTokens(field.ItemFields, item, service, variable)
+	case common.ObjectList:
+		items, ok := value.([]any)
+		if !ok {
+			return nil
+		}
+		elements := make([]hclwrite.Tokens, 0, len(items))
+		for i, item := range items {
+			if m, ok := item.(map[string]any); ok {
+				elements = append(elements, e.configObjectTokens(field.ItemFields, m, service, fmt.Sprintf("%v_%v", variable, i)))
+			}
+		}
+		return hclwrite.TokensForTuple(elements)
+	}
+	return nil
+}
+
+func (e *exporter) configObjectTokens(fields map[string]common.ConfigField, values map[string]any, service, variable string) hclwrite.Tokens {
+	tokens := map[string]hclwrite.Tokens{}
+	for _, name := range sortedKeys(fields) {
+		if value, ok := configFieldValue(fields, name, values, service); ok {
+			if t := e.configFieldTokens(fields[name], value, service, variable+"_"+name); t != nil {
+				tokens[name] = t
+			}
+		}
+	}
+	return objectTokens(sortedKeys(tokens), func(key string) hclwrite.Tokens { return tokens[key] })
+}
+
+// setConfigFields writes the config values into the body of a config block, object config fields are written as nested
+// blocks on the top level of the block (as the config block schema defines them) and as attributes below.
+func (e *exporter) setConfigFields(body *hclwrite.Body, fields map[string]common.ConfigField, values map[string]any, service, variable string, nested bool) {
+	for _, name := range sortedKeys(fields) {
+		field := fields[name]
+		value, ok := configFieldValue(fields, name, values, service)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case field.FieldValueType == common.ObjectList:
+			items, _ := value.([]any)
+			for i, item := range items {
+				if m, ok := item.(map[string]any); ok {
+					e.setConfigFields(body.AppendNewBlock(name, nil).Body(), field.ItemFields, m, service, fmt.Sprintf("%v_%v_%v", variable, name, i), true)
+				}
+			}
+		case field.FieldValueType == common.Object && !nested:
+			if m, ok := value.(map[string]any); ok {
+				e.setConfigFields(body.AppendNewBlock(name, nil).Body(), field.ItemFields, m, service, variable+"_"+name, true)
+			}
+		default:
+			if tokens := e.configFieldTokens(field, value, service, variable+"_"+name); tokens != nil {
+				body.SetAttributeRaw(name, tokens)
+			}
+		}
+	}
+}
+
+// configFieldValue returns the API value of the config field. Read only fields are skipped as they can't be configured,
+// generic fields are skipped when the service has its own `<field>_<service>` field for the same API field.
+func configFieldValue(fields map[string]common.ConfigField, name string, values map[string]any, service string) (any, bool) {
+	if _, ok := fields[name+"_"+service]; ok {
+		return nil, false
+	}
+	field := fields[name]
+	value, ok := values[apiKey(name, field)]
+	return value, ok && value != nil && !field.Readonly
+}
+
+// apiKey returns the key of the config field in the API config.
+func apiKey(name string, field common.ConfigField) string {
+	if field.ApiField != "" {
+		return field.ApiField
+	}
+	return name
+}
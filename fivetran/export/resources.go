@@ -0,0 +1,474 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/fivetran/go-fivetran/connections"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/common"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// destinationSchemaKeys are the config keys managed by the `destination_schema` block of `fivetran_connection`.
+var destinationSchemaKeys = map[string]bool{"schema": true, "table": true, "schema_prefix": true, "table_group_name": true}
+
+// exported is a resource other resources are exported for.
+type exported struct {
+	id   string
+	name string
+}
+
+// pageError adds the response code to the error of a list page.
+func pageError(err error, code string) error {
+	if err != nil {
+		return fmt.Errorf("%v; code: %v", err, code)
+	}
+	return nil
+}
+
+func setString(body *hclwrite.Body, name, value string) {
+	if value != "" {
+		body.SetAttributeValue(name, cty.StringVal(value))
+	}
+}
+
+func (e *exporter) exportGroups() error {
+	resp, err := core.ListGroups(e.ctx, e.client)
+	if err != nil {
+		return fmt.Errorf("Unable to read groups. %v; code: %v; message: %v", err, resp.Code, resp.Message)
+	}
+	for _, group := range resp.Data.Items {
+		body, name := e.resource("groups.tf", "fivetran_group", group.Name, "group", group.ID)
+		body.SetAttributeValue("name", cty.StringVal(group.Name))
+		e.groups[group.ID] = name
+	}
+	return nil
+}
+
+func (e *exporter) groupName(groupId string) string {
+	if name, ok := e.groups[groupId]; ok {
+		return name
+	}
+	return groupId
+}
+
+func (e *exporter) exportDestinations() error {
+	resp, err := core.ListDestinations(e.ctx, e.client)
+	if err != nil {
+		return fmt.Errorf("Unable to read destinations. %v; code: %v; message: %v", err, resp.Code, resp.Message)
+	}
+	for _, destination := range resp.Data.Items {
+		details, err := e.client.NewDestinationDetails().DestinationID(destination.ID).DoCustom(e.ctx)
+		if err != nil {
+			e.warn("config of destination %v is skipped. %v; code: %v; message: %v", destination.ID, err, details.Code, details.Message)
+		} else {
+			destination = details.Data.DestinationDetailsBase
+		}
+
+		body, name := e.resource("destinations.tf", "fivetran_destination", e.groupName(destination.GroupID), "destination", destination.ID)
+		body.SetAttributeRaw("group_id", e.reference("group", destination.GroupID))
+		setString(body, "service", destination.Service)
+		setString(body, "region", destination.Region)
+		setString(body, "time_zone_offset", destination.TimeZoneOffset)
+		body.SetAttributeValue("daylight_saving_time_enabled", cty.BoolVal(destination.DaylightSavingTimeEnabled))
+		setString(body, "networking_method", destination.NetworkingMethod)
+		setString(body, "private_link_id", destination.PrivateLinkId)
+		setString(body, "hybrid_deployment_agent_id", destination.HybridDeploymentAgentId)
+		setString(body, "proxy_agent_id", destination.ProxyAgentId)
+		if err == nil {
+			config := body.AppendNewBlock("config", nil).Body()
+			e.setConfigFields(config, common.GetDestinationFieldsForService(destination.Service), details.Data.Config, destination.Service, name, false)
+		}
+	}
+	return nil
+}
+
+func (e *exporter) exportConnections() error {
+	resp, err := core.ListConnections(e.ctx, e.client, "", "")
+	if err != nil {
+		return fmt.Errorf("Unable to read connections. %v; code: %v; message: %v", err, resp.Code, resp.Message)
+	}
+	for _, connection := range resp.Data.Items {
+		body, name := e.resource("connections.tf", "fivetran_connection", e.groupName(connection.GroupID)+"_"+connection.Schema, "connection", connection.ID)
+		body.SetAttributeRaw("group_id", e.reference("group", connection.GroupID))
+		body.SetAttributeValue("service", cty.StringVal(connection.Service))
+		setDestinationSchema(body.AppendNewBlock("destination_schema", nil).Body(), connection.Service, connection.Schema)
+		setString(body, "networking_method", connection.NetworkingMethod)
+		setString(body, "proxy_agent_id", connection.ProxyAgentId)
+		setString(body, "private_link_id", connection.PrivateLinkId)
+		setString(body, "hybrid_deployment_agent_id", connection.HybridDeploymentAgentId)
+		setString(body, "data_delay_sensitivity", connection.DataDelaySensitivity)
+		if connection.DataDelayThreshold != nil {
+			body.SetAttributeValue("data_delay_threshold", cty.NumberIntVal(int64(*connection.DataDelayThreshold)))
+		}
+
+		e.exportConnectionConfig(connection, name)
+		e.exportSchemaConfig(connection, name)
+	}
+	return nil
+}
+
+// setDestinationSchema splits the connection schema into the `destination_schema` fields the service supports.
+func setDestinationSchema(body *hclwrite.Body, service, schema string) {
+	fields, ok := common.GetDestinationSchemaFields()[service]
+	if ok && fields["schema_prefix"] {
+		body.SetAttributeValue("prefix", cty.StringVal(schema))
+		return
+	}
+
+	name, table, found := strings.Cut(schema, ".")
+	body.SetAttributeValue("name", cty.StringVal(name))
+	if found && fields["table"] {
+		body.SetAttributeValue("table", cty.StringVal(table))
+	}
+	if found && fields["table_group_name"] {
+		body.SetAttributeValue("table_group_name", cty.StringVal(table))
+	}
+}
+
+// exportConnectionConfig exports the config of the connection as `fivetran_connection_config` with the config set as
+// dynamic JSON object, the same way `fivetran_connection_v2` manages it. Read only fields are left out, secrets the API
+// masks become variables.
+func (e *exporter) exportConnectionConfig(connection connections.DetailsResponseDataCommon, name string) {
+	details, err := e.client.NewConnectionDetails().ConnectionID(connection.ID).DoCustom(e.ctx)
+	if err != nil {
+		e.warn("config of connection %v is skipped. %v; code: %v; message: %v", connection.ID, err, details.Code, details.Message)
+		return
+	}
+
+	// read only fields common for all services are not described per service
+	readonly := map[string]bool{}
+	for fieldName, field := range common.GetConfigFieldsMap() {
+		readonly[apiKey(fieldName, field)] = readonly[apiKey(fieldName, field)] || field.Readonly
+	}
+	sensitive := map[string]bool{}
+	fields, err := common.GetFieldsForService(connection.Service)
+	if err != nil {
+		e.warn("config of connection %v is exported without field metadata, review it for read only fields. %v", connection.ID, err)
+	}
+	for fieldName, field := range fields {
+		sensitive[apiKey(fieldName, field)] = sensitive[apiKey(fieldName, field)] || field.GetIsSensitive(connection.Service)
+	}
+
+	keys := []string{}
+	for _, key := range sortedKeys(details.Data.Config) {
+		if details.Data.Config[key] != nil && !readonly[key] && !destinationSchemaKeys[key] {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return
+	}
+
+	body, _ := e.resource("connections.tf", "fivetran_connection_config", name, "", connection.ID)
+	body.SetAttributeRaw("connection_id", e.reference("connection", connection.ID))
+	body.SetAttributeRaw("config", hclwrite.TokensForFunctionCall("jsonencode", objectTokens(keys, func(key string) hclwrite.Tokens {
+		return e.jsonTokens(details.Data.Config[key], name+"_"+key, sensitive[key])
+	})))
+}
+
+// exportSchemaConfig exports the schema config of the connection as `fivetran_connector_schema_config`, the schemas are
+// written into a `schemas/<name>.json` file referenced by `schemas_json`.
+func (e *exporter) exportSchemaConfig(connection connections.DetailsResponseDataCommon, name string) {
+	resp, err := e.client.NewConnectionSchemaDetails().ConnectionID(connection.ID).Do(e.ctx)
+	if err != nil {
+		e.warn("schema config of connection %v is skipped. %v; code: %v; message: %v", connection.ID, err, resp.Code, resp.Message)
+		return
+	}
+	if len(resp.Data.Schemas) == 0 {
+		return
+	}
+
+	schemas := map[string]any{}
+	for schemaName, schema := range resp.Data.Schemas {
+		tables := map[string]any{}
+		for tableName, table := range schema.Tables {
+			columns := map[string]any{}
+			for columnName, column := range table.Columns {
+				columns[columnName] = withoutNil(map[string]any{"enabled": column.Enabled, "hashed": column.Hashed})
+			}
+			tableConfig := withoutNil(map[string]any{"enabled": table.Enabled, "sync_mode": table.SyncMode})
+			if len(columns) > 0 {
+				tableConfig["columns"] = columns
+			}
+			tables[tableName] = tableConfig
+		}
+		schemaConfig := withoutNil(map[string]any{"enabled": schema.Enabled})
+		if len(tables) > 0 {
+			schemaConfig["tables"] = tables
+		}
+		schemas[schemaName] = schemaConfig
+	}
+	data, err := json.MarshalIndent(schemas, "", "    ")
+	if err != nil {
+		e.warn("schema config of connection %v is skipped. %v", connection.ID, err)
+		return
+	}
+
+	body, name := e.resource("connections.tf", "fivetran_connector_schema_config", name, "", connection.ID)
+	file := name + ".json"
+	e.schemas[file] = append(data, '\n')
+	body.SetAttributeRaw("connector_id", e.reference("connection", connection.ID))
+	setString(body, "schema_change_handling", resp.Data.SchemaChangeHandling)
+	body.SetAttributeRaw("schemas_json", hclwrite.TokensForFunctionCall("file", modulePathTokens("schemas/"+file)))
+}
+
+func withoutNil(values map[string]any) map[string]any {
+	result := map[string]any{}
+	for key, value := range values {
+		switch v := value.(type) {
+		case *bool:
+			if v != nil {
+				result[key] = *v
+			}
+		case *string:
+			if v != nil {
+				result[key] = *v
+			}
+		}
+	}
+	return result
+}
+
+func (e *exporter) exportUsers() error {
+	resp, err := core.ListUsers(e.ctx, e.client)
+	if err != nil {
+		return fmt.Errorf("Unable to read users. %v; code: %v; message: %v", err, resp.Code, resp.Message)
+	}
+	for _, user := range resp.Data.Items {
+		body, name := e.resource("users.tf", "fivetran_user", user.Email, "user", user.ID)
+		body.SetAttributeValue("email", cty.StringVal(user.Email))
+		setString(body, "given_name", user.GivenName)
+		setString(body, "family_name", user.FamilyName)
+		setString(body, "phone", user.Phone)
+		setString(body, "picture", user.Picture)
+		setString(body, "role", user.Role)
+		e.users = append(e.users, exported{id: user.ID, name: name})
+	}
+	return nil
+}
+
+func (e *exporter) exportTeams() error {
+	resp, err := core.ListTeams(e.ctx, e.client)
+	if err != nil {
+		return fmt.Errorf("Unable to read teams. %v; code: %v", err, resp.Code)
+	}
+	for _, team := range resp.Data.Items {
+		body, name := e.resource("teams.tf", "fivetran_team", team.Name, "team", team.Id)
+		body.SetAttributeValue("name", cty.StringVal(team.Name))
+		setString(body, "description", team.Description)
+		body.SetAttributeValue("role", cty.StringVal(team.Role))
+		e.teams = append(e.teams, exported{id: team.Id, name: name})
+	}
+	return nil
+}
+
+// membership is a membership of a team or user in a connection or group.
+type membership struct {
+	id   string
+	role string
+}
+
+// exportMemberships exports the connection, group and user memberships of the teams and the connection and group
+// memberships of the users, the membership resources manage all memberships of a kind of the team or user.
+func (e *exporter) exportMemberships() error {
+	for _, team := range e.teams {
+		e.exportMembership("fivetran_team_connector_membership", "team_id", "team", team, "connector", "connection", func(cursor string) ([]membership, string, error) {
+			resp, err := core.WithCursor(e.client.NewTeamConnectionMembershipsList().TeamId(team.id).Limit(core.ListPageLimit), cursor).Do(e.ctx)
+			items := []membership{}
+			for _, item := range resp.Data.Items {
+				items = append(items, membership{id: item.ConnectionId, role: item.Role})
+			}
+			return items, resp.Data.NextCursor, pageError(err, resp.Code)
+		})
+		e.exportMembership("fivetran_team_group_membership", "team_id", "team", team, "group", "group", func(cursor string) ([]membership, string, error) {
+			resp, err := core.WithCursor(e.client.NewTeamGroupMembershipsList().TeamId(team.id).Limit(core.ListPageLimit), cursor).Do(e.ctx)
+			items := []membership{}
+			for _, item := range resp.Data.Items {
+				items = append(items, membership{id: item.GroupId, role: item.Role})
+			}
+			return items, resp.Data.NextCursor, pageError(err, resp.Code)
+		})
+		e.exportMembership("fivetran_team_user_membership", "team_id", "team", team, "user", "user", func(cursor string) ([]membership, string, error) {
+			resp, err := core.WithCursor(e.client.NewTeamUserMembershipsList().TeamId(team.id).Limit(core.ListPageLimit), cursor).Do(e.ctx)
+			items := []membership{}
+			for _, item := range resp.Data.Items {
+				items = append(items, membership{id: item.UserId, role: item.Role})
+			}
+			return items, resp.Data.NextCursor, pageError(err, resp.Code)
+		})
+	}
+
+	for _, user := range e.users {
+		e.exportMembership("fivetran_user_connector_membership", "user_id", "user", user, "connector", "connection", func(cursor string) ([]membership, string, error) {
+			resp, err := core.WithCursor(e.client.NewUserConnectionMembershipsList().UserId(user.id).Limit(core.ListPageLimit), cursor).Do(e.ctx)
+			items := []membership{}
+			for _, item := range resp.Data.Items {
+				items = append(items, membership{id: item.ConnectionId, role: item.Role})
+			}
+			return items, resp.Data.NextCursor, pageError(err, resp.Code)
+		})
+		e.exportMembership("fivetran_user_group_membership", "user_id", "user", user, "group", "group", func(cursor string) ([]membership, string, error) {
+			resp, err := core.WithCursor(e.client.NewUserGroupMembershipsList().UserId(user.id).Limit(core.ListPageLimit), cursor).Do(e.ctx)
+			items := []membership{}
+			for _, item := range resp.Data.Items {
+				items = append(items, membership{id: item.GroupId, role: item.Role})
+			}
+			return items, resp.Data.NextCursor, pageError(err, resp.Code)
+		})
+	}
+	return nil
+}
+
+// exportMembership exports the memberships of the owner as one resource with a `<block>` block per membership,
+// the `<block>_id` attribute of the block references the member of the kind.
+func (e *exporter) exportMembership(resourceType, ownerAttribute, ownerKind string, owner exported, block, kind string, page func(cursor string) ([]membership, string, error)) {
+	memberships, err := core.ListAll(page)
+	if err != nil {
+		e.warn("%v of %v %v is skipped. %v", resourceType, ownerKind, owner.id, err)
+		return
+	}
+	if len(memberships) == 0 {
+		return
+	}
+
+	body, _ := e.resource("memberships.tf", resourceType, owner.name, "", owner.id)
+	body.SetAttributeRaw(ownerAttribute, e.reference(ownerKind, owner.id))
+	for _, m := range memberships {
+		item := body.AppendNewBlock(block, nil).Body()
+		item.SetAttributeRaw(block+"_id", e.reference(kind, m.id))
+		item.SetAttributeValue("role", cty.StringVal(m.role))
+	}
+}
+
+func (e *exporter) exportWebhooks() error {
+	resp, err := core.ListWebhooks(e.ctx, e.client)
+	if err != nil {
+		return fmt.Errorf("Unable to read webhooks. %v; code: %v", err, resp.Code)
+	}
+	for _, webhook := range resp.Data.Items {
+		base := "account"
+		if webhook.GroupId != "" {
+			base = e.groupName(webhook.GroupId)
+		}
+		body, name := e.resource("webhooks.tf", "fivetran_webhook", base, "", webhook.Id)
+		body.SetAttributeValue("type", cty.StringVal(webhook.Type))
+		if webhook.GroupId != "" {
+			body.SetAttributeRaw("group_id", e.reference("group", webhook.GroupId))
+		}
+		body.SetAttributeValue("url", cty.StringVal(webhook.Url))
+		body.SetAttributeRaw("events", stringsTokens(webhook.Events))
+		body.SetAttributeValue("active", cty.BoolVal(webhook.Active))
+		body.SetAttributeRaw("secret", e.secret(name+"_secret"))
+	}
+	return nil
+}
+
+func (e *exporter) exportTransformations() error {
+	projects, err := core.ListAll(func(cursor string) ([]exported, string, error) {
+		resp, err := core.WithCursor(e.client.NewTransformationProjectsList().Limit(core.ListPageLimit), cursor).Do(e.ctx)
+		items := []exported{}
+		for _, item := range resp.Data.Items {
+			items = append(items, exported{id: item.Id})
+		}
+		return items, resp.Data.NextCursor, pageError(err, resp.Code)
+	})
+	if err != nil {
+		return fmt.Errorf("Unable to read transformation projects. %v", err)
+	}
+	for _, project := range projects {
+		details, err := e.client.NewTransformationProjectDetails().ProjectId(project.id).Do(e.ctx)
+		if err != nil {
+			e.warn("transformation project %v is skipped. %v; code: %v; message: %v", project.id, err, details.Code, details.Message)
+			continue
+		}
+
+		body, _ := e.resource("transformations.tf", "fivetran_transformation_project", e.groupName(details.Data.GroupId), "transformation_project", project.id)
+		body.SetAttributeRaw("group_id", e.reference("group", details.Data.GroupId))
+		body.SetAttributeValue("type", cty.StringVal(details.Data.ProjectType))
+		config := body.AppendNewBlock("project_config", nil).Body()
+		setString(config, "dbt_version", details.Data.ProjectConfig.DbtVersion)
+		setString(config, "default_schema", details.Data.ProjectConfig.DefaultSchema)
+		setString(config, "git_remote_url", details.Data.ProjectConfig.GitRemoteUrl)
+		setString(config, "folder_path", details.Data.ProjectConfig.FolderPath)
+		setString(config, "git_branch", details.Data.ProjectConfig.GitBranch)
+		setString(config, "target_name", details.Data.ProjectConfig.TargetName)
+		if len(details.Data.ProjectConfig.EnvironmentVars) > 0 {
+			config.SetAttributeRaw("environment_vars", stringsTokens(details.Data.ProjectConfig.EnvironmentVars))
+		}
+		if details.Data.ProjectConfig.Threads > 0 {
+			config.SetAttributeValue("threads", cty.NumberIntVal(int64(details.Data.ProjectConfig.Threads)))
+		}
+	}
+
+	resp, err := core.ListTransformations(e.ctx, e.client)
+	if err != nil {
+		return fmt.Errorf("Unable to read transformations. %v; code: %v; message: %v", err, resp.Code, resp.Message)
+	}
+	for _, transformation := range resp.Data.Items {
+		config := transformation.TransformationConfig
+		base := config.Name
+		if base == "" {
+			base = config.PackageName
+		}
+		if base == "" {
+			base = transformation.Id
+		}
+		body, _ := e.resource("transformations.tf", "fivetran_transformation", base, "", transformation.Id)
+		body.SetAttributeValue("type", cty.StringVal(transformation.ProjectType))
+		body.SetAttributeValue("paused", cty.BoolVal(transformation.Paused))
+
+		schedule := body.AppendNewBlock("schedule", nil).Body()
+		setString(schedule, "schedule_type", transformation.TransformationSchedule.ScheduleType)
+		if len(transformation.TransformationSchedule.Cron) > 0 {
+			schedule.SetAttributeRaw("cron", stringsTokens(transformation.TransformationSchedule.Cron))
+		}
+		if len(transformation.TransformationSchedule.ConnectionIds) > 0 {
+			schedule.SetAttributeRaw("connection_ids", e.references("connection", transformation.TransformationSchedule.ConnectionIds))
+		}
+		if len(transformation.TransformationSchedule.DaysOfWeek) > 0 {
+			schedule.SetAttributeRaw("days_of_week", stringsTokens(transformation.TransformationSchedule.DaysOfWeek))
+		}
+		if transformation.TransformationSchedule.Interval > 0 {
+			schedule.SetAttributeValue("interval", cty.NumberIntVal(int64(transformation.TransformationSchedule.Interval)))
+		}
+		setString(schedule, "time_of_day", transformation.TransformationSchedule.TimeOfDay)
+		if transformation.TransformationSchedule.SmartSyncing {
+			schedule.SetAttributeValue("smart_syncing", cty.True)
+		}
+
+		configBody := body.AppendNewBlock("transformation_config", nil).Body()
+		if config.ProjectId != "" {
+			configBody.SetAttributeRaw("project_id", e.reference("transformation_project", config.ProjectId))
+		}
+		setString(configBody, "name", config.Name)
+		if len(config.Steps) > 0 {
+			steps := make([]hclwrite.Tokens, 0, len(config.Steps))
+			for _, step := range config.Steps {
+				steps = append(steps, objectTokens([]string{"name", "command"}, func(key string) hclwrite.Tokens {
+					if key == "name" {
+						return stringTokens(step.Name)
+					}
+					return stringTokens(step.Command)
+				}))
+			}
+			configBody.SetAttributeRaw("steps", hclwrite.TokensForTuple(steps))
+		}
+		setString(configBody, "package_name", config.PackageName)
+		if len(config.ConnectionIds) > 0 {
+			configBody.SetAttributeRaw("connection_ids", e.references("connection", config.ConnectionIds))
+		}
+		if len(config.ExcludedModels) > 0 {
+			configBody.SetAttributeRaw("excluded_models", stringsTokens(config.ExcludedModels))
+		}
+		if len(config.ConfigurableVariables) > 0 {
+			configBody.SetAttributeRaw("configurable_variables", objectTokens(sortedKeys(config.ConfigurableVariables), func(key string) hclwrite.Tokens {
+				return stringTokens(stringValue(config.ConfigurableVariables[key]))
+			}))
+		}
+	}
+	return nil
+}
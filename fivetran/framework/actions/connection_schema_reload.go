@@ -0,0 +1,174 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/fivetran/go-fivetran/connections"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func ConnectionSchemaReload() action.Action {
+	return &connectionSchemaReload{}
+}
+
+type connectionSchemaReload struct {
+	core.ProviderAction
+}
+
+type connectionSchemaReloadConfig struct {
+	ConnectionId types.String `tfsdk:"connection_id"`
+	ExcludeMode  types.String `tfsdk:"exclude_mode"`
+}
+
+var _ action.ActionWithConfigure = &connectionSchemaReload{}
+
+func (a *connectionSchemaReload) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connection_schema_reload"
+}
+
+func (a *connectionSchemaReload) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = actionschema.Schema{
+		Description: "Reloads the connection schema config from the source and reports added and removed schemas, tables and columns.",
+		Attributes: map[string]actionschema.Attribute{
+			"connection_id": actionschema.StringAttribute{
+				Required:    true,
+				Description: "The unique identifier of the connection to reload the schema for.",
+			},
+			"exclude_mode": actionschema.StringAttribute{
+				Optional:    true,
+				Description: "`PRESERVE` keeps the current settings of existing elements and handles new ones according to `schema_change_handling`, `EXCLUDE` disables all elements of the reloaded schema. Defaults to `PRESERVE`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("PRESERVE", "EXCLUDE"),
+				},
+			},
+		},
+	}
+}
+
+func (a *connectionSchemaReload) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	if a.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	var config connectionSchemaReloadConfig
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectionId := config.ConnectionId.ValueString()
+	excludeMode := "PRESERVE"
+	if !config.ExcludeMode.IsNull() && !config.ExcludeMode.IsUnknown() {
+		excludeMode = config.ExcludeMode.ValueString()
+	}
+
+	// the schema config doesn't exist until the first reload, everything reloaded is reported as added then
+	before, err := a.GetClient().NewConnectionSchemaDetails().ConnectionID(connectionId).Do(ctx)
+	if err != nil && before.Code != "NotFound_SchemaConfig" {
+		resp.Diagnostics.AddError(
+			"Unable to Reload Connection Schema",
+			fmt.Sprintf("Error while reading schema config. %v; code: %v; message: %v", err, before.Code, before.Message),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Reloading schema of connection %q with exclude mode %v...", connectionId, excludeMode),
+	})
+
+	if fetcher := a.GetColumnFetcher(); fetcher != nil {
+		fetcher.Invalidate(connectionId)
+	}
+	after, err := a.GetClient().NewConnectionSchemaReload().ConnectionID(connectionId).ExcludeMode(excludeMode).Do(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Reload Connection Schema",
+			fmt.Sprintf("%v; code: %v; message: %v", err, after.Code, after.Message),
+		)
+		return
+	}
+
+	changes := schemaChanges(before, after)
+	for _, change := range changes {
+		resp.SendProgress(action.InvokeProgressEvent{Message: change})
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Schema of connection %q reloaded, %d change(s) found.", connectionId, len(changes)),
+	})
+}
+
+// schemaChanges lists schemas, tables and columns added or removed by the reload, sorted by element path.
+// Columns of added and removed tables are not listed separately. Neither the schema config of tables that were never
+// fetched nor the reload response have to return columns, so columns are compared only if both sides returned them.
+func schemaChanges(before, after connections.ConnectionSchemaDetailsResponse) []string {
+	var changes []string
+	for _, sName := range sortedNames(before.Data.Schemas, after.Data.Schemas) {
+		beforeSchema, existed := before.Data.Schemas[sName]
+		afterSchema, exists := after.Data.Schemas[sName]
+		if !existed || beforeSchema == nil {
+			if exists && afterSchema != nil {
+				changes = append(changes, fmt.Sprintf("Schema added: %v", sName))
+			}
+			continue
+		}
+		if !exists || afterSchema == nil {
+			changes = append(changes, fmt.Sprintf("Schema removed: %v", sName))
+			continue
+		}
+
+		for _, tName := range sortedNames(beforeSchema.Tables, afterSchema.Tables) {
+			beforeTable, existed := beforeSchema.Tables[tName]
+			afterTable, exists := afterSchema.Tables[tName]
+			if !existed || beforeTable == nil {
+				if exists && afterTable != nil {
+					changes = append(changes, fmt.Sprintf("Table added: %v.%v", sName, tName))
+				}
+				continue
+			}
+			if !exists || afterTable == nil {
+				changes = append(changes, fmt.Sprintf("Table removed: %v.%v", sName, tName))
+				continue
+			}
+			if len(beforeTable.Columns) == 0 || len(afterTable.Columns) == 0 {
+				continue
+			}
+
+			for _, cName := range sortedNames(beforeTable.Columns, afterTable.Columns) {
+				_, existed := beforeTable.Columns[cName]
+				_, exists := afterTable.Columns[cName]
+				if !existed {
+					changes = append(changes, fmt.Sprintf("Column added: %v.%v.%v", sName, tName, cName))
+				} else if !exists {
+					changes = append(changes, fmt.Sprintf("Column removed: %v.%v.%v", sName, tName, cName))
+				}
+			}
+		}
+	}
+	return changes
+}
+
+func sortedNames[T any](before, after map[string]T) []string {
+	names := make([]string, 0, len(before)+len(after))
+	for name := range before {
+		names = append(names, name)
+	}
+	for name := range after {
+		if _, ok := before[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
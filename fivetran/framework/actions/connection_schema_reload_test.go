@@ -0,0 +1,225 @@
+package actions
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	fivetranSdk "github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/tests/mock"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestConnectionSchemaReload_Invoke_ReportsChanges(t *testing.T) {
+	mockClient := mock.NewHttpClient()
+
+	mockClient.When(http.MethodGet, "/v1/connections/connection_id/schemas").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			return mock.NewResponse(req, 200, `{
+				"code": "Success",
+				"data": {
+					"schema_change_handling": "ALLOW_ALL",
+					"schemas": {
+						"public": {"enabled": true, "tables": {
+							"users": {"enabled": true, "columns": {"id": {"enabled": true}, "legacy": {"enabled": true}}},
+							"orders": {"enabled": true},
+							"archive": {"enabled": true}
+						}},
+						"old": {"enabled": true}
+					}
+				}
+			}`), nil
+		},
+	)
+	var requestBody string
+	reloadHandler := mockClient.When(http.MethodPost, "/v1/connections/connection_id/schemas/reload").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			requestBody = string(body)
+			return mock.NewResponse(req, 200, `{
+				"code": "Success",
+				"data": {
+					"schema_change_handling": "ALLOW_ALL",
+					"schemas": {
+						"public": {"enabled": true, "tables": {
+							"users": {"enabled": true, "columns": {"id": {"enabled": true}, "email": {"enabled": true}}},
+							"orders": {"enabled": true, "columns": {"id": {"enabled": true}}},
+							"payments": {"enabled": true, "columns": {"id": {"enabled": true}}}
+						}},
+						"sales": {"enabled": true}
+					}
+				}
+			}`), nil
+		},
+	)
+
+	a := configureConnectionSchemaReload(t, mockClient)
+	var progressMessages []string
+	resp := &action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			progressMessages = append(progressMessages, event.Message)
+		},
+	}
+	a.Invoke(context.Background(), action.InvokeRequest{Config: connectionSchemaReloadTestConfig(t, "EXCLUDE")}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected invoke errors: %v", resp.Diagnostics)
+	}
+	if reloadHandler.Interactions != 1 || !strings.Contains(requestBody, `"exclude_mode":"EXCLUDE"`) {
+		t.Errorf("unexpected reload request: interactions %d, body %v", reloadHandler.Interactions, requestBody)
+	}
+
+	// orders had no columns returned before the reload, so its columns are not compared
+	expected := []string{
+		"Schema removed: old",
+		"Table removed: public.archive",
+		"Table added: public.payments",
+		"Column added: public.users.email",
+		"Column removed: public.users.legacy",
+		"Schema added: sales",
+	}
+	if len(progressMessages) != len(expected)+2 {
+		t.Fatalf("unexpected progress messages: %v", progressMessages)
+	}
+	if changes := progressMessages[1 : len(progressMessages)-1]; !reflect.DeepEqual(changes, expected) {
+		t.Errorf("unexpected changes: %v", changes)
+	}
+}
+
+func TestConnectionSchemaReload_Invoke_ReloadWithoutColumns(t *testing.T) {
+	mockClient := mock.NewHttpClient()
+
+	mockClient.When(http.MethodGet, "/v1/connections/connection_id/schemas").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			return mock.NewResponse(req, 200, `{
+				"code": "Success",
+				"data": {"schema_change_handling": "ALLOW_ALL", "schemas": {"public": {"enabled": true, "tables": {
+					"users": {"enabled": true, "columns": {"id": {"enabled": true}, "email": {"enabled": true}}}
+				}}}}
+			}`), nil
+		},
+	)
+	mockClient.When(http.MethodPost, "/v1/connections/connection_id/schemas/reload").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			return mock.NewResponse(req, 200, `{
+				"code": "Success",
+				"data": {"schema_change_handling": "ALLOW_ALL", "schemas": {"public": {"enabled": true, "tables": {
+					"users": {"enabled": true}
+				}}}}
+			}`), nil
+		},
+	)
+
+	a := configureConnectionSchemaReload(t, mockClient)
+	var progressMessages []string
+	resp := &action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			progressMessages = append(progressMessages, event.Message)
+		},
+	}
+	a.Invoke(context.Background(), action.InvokeRequest{Config: connectionSchemaReloadTestConfig(t, "")}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected invoke errors: %v", resp.Diagnostics)
+	}
+	// the reload response doesn't return columns, they are not reported as removed
+	if len(progressMessages) != 2 || !strings.Contains(progressMessages[1], "0 change(s) found") {
+		t.Errorf("unexpected progress messages: %v", progressMessages)
+	}
+}
+
+func TestConnectionSchemaReload_Invoke_SchemaConfigNotFound(t *testing.T) {
+	mockClient := mock.NewHttpClient()
+
+	mockClient.When(http.MethodGet, "/v1/connections/connection_id/schemas").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			return mock.NewResponse(req, 404, `{"code": "NotFound_SchemaConfig", "message": "Schema config not found"}`), nil
+		},
+	)
+	mockClient.When(http.MethodPost, "/v1/connections/connection_id/schemas/reload").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			return mock.NewResponse(req, 200, `{
+				"code": "Success",
+				"data": {"schema_change_handling": "ALLOW_ALL", "schemas": {"public": {"enabled": true}}}
+			}`), nil
+		},
+	)
+
+	a := configureConnectionSchemaReload(t, mockClient)
+	var progressMessages []string
+	resp := &action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			progressMessages = append(progressMessages, event.Message)
+		},
+	}
+	a.Invoke(context.Background(), action.InvokeRequest{Config: connectionSchemaReloadTestConfig(t, "")}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected invoke errors: %v", resp.Diagnostics)
+	}
+	if len(progressMessages) != 3 || progressMessages[1] != "Schema added: public" {
+		t.Errorf("unexpected progress messages: %v", progressMessages)
+	}
+}
+
+func TestConnectionSchemaReload_Invoke_ApiError(t *testing.T) {
+	mockClient := mock.NewHttpClient()
+
+	mockClient.When(http.MethodGet, "/v1/connections/connection_id/schemas").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			return mock.NewResponse(req, 404, `{"code": "NotFound_Connection", "message": "Connection not found"}`), nil
+		},
+	)
+
+	a := configureConnectionSchemaReload(t, mockClient)
+	resp := &action.InvokeResponse{SendProgress: func(event action.InvokeProgressEvent) {}}
+	a.Invoke(context.Background(), action.InvokeRequest{Config: connectionSchemaReloadTestConfig(t, "")}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected error diagnostics for API error")
+	}
+}
+
+// -- helpers --
+
+func configureConnectionSchemaReload(t *testing.T, mockClient *mock.HttpClient) *connectionSchemaReload {
+	t.Helper()
+
+	client := fivetranSdk.New("test_key", "test_secret")
+	client.BaseURL("https://api.fivetran.com/v1")
+	client.SetHttpClient(mockClient)
+
+	a := &connectionSchemaReload{}
+	configureResp := &action.ConfigureResponse{}
+	a.Configure(context.Background(), action.ConfigureRequest{ProviderData: client}, configureResp)
+
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected configure errors: %v", configureResp.Diagnostics)
+	}
+	return a
+}
+
+func connectionSchemaReloadTestConfig(t *testing.T, excludeMode string) tfsdk.Config {
+	t.Helper()
+
+	schemaResp := &action.SchemaResponse{}
+	(&connectionSchemaReload{}).Schema(context.Background(), action.SchemaRequest{}, schemaResp)
+
+	excludeModeValue := tftypes.NewValue(tftypes.String, nil)
+	if excludeMode != "" {
+		excludeModeValue = tftypes.NewValue(tftypes.String, excludeMode)
+	}
+
+	return tfsdk.Config{
+		Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+			"connection_id": tftypes.NewValue(tftypes.String, "connection_id"),
+			"exclude_mode":  excludeModeValue,
+		}),
+		Schema: schemaResp.Schema,
+	}
+}
@@ -0,0 +1,25 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/connections"
+	"github.com/fivetran/terraform-provider-fivetran/modules/helpers"
+)
+
+// ListConnectionDetails reads the details of the connections with up to `concurrency` parallel requests and returns them
+// in the order of the ids. The first failed request cancels the requests which haven't started yet and its error is returned.
+func ListConnectionDetails(ctx context.Context, client *fivetran.Client, ids []string, concurrency int) ([]connections.DetailsWithCustomConfigNoTestsResponse, error) {
+	result := make([]connections.DetailsWithCustomConfigNoTestsResponse, len(ids))
+	err := helpers.ForEachBounded(ctx, concurrency, len(ids), func(ctx context.Context, i int) error {
+		response, err := client.NewConnectionDetails().ConnectionID(ids[i]).DoCustom(ctx)
+		if err != nil {
+			return fmt.Errorf("connection %v: %v; code: %v; message: %v", ids[i], err, response.Code, response.Message)
+		}
+		result[i] = response
+		return nil
+	})
+	return result, err
+}
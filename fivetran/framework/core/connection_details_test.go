@@ -0,0 +1,126 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	fivetran "github.com/fivetran/go-fivetran"
+	configSchema "github.com/fivetran/terraform-provider-fivetran/modules/connector/schema"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func newConnectionDetailsServer(t *testing.T, inFlight, maxInFlight, calls *atomic.Int32, failing string) *fivetran.Client {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, ok := strings.CutPrefix(r.URL.Path, "/connections/")
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		calls.Add(1)
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			observed := maxInFlight.Load()
+			if current <= observed || maxInFlight.CompareAndSwap(observed, current) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		w.Header().Set("Content-Type", "application/json")
+		if id == failing {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":"NotFound_Connection","message":"Connection not found"}`)) //nolint:errcheck
+			return
+		}
+		fmt.Fprintf(w, `{"code":"Success","data":{"id":%q,"config":{"host":"%v.example.com"},"status":{"setup_state":"connected"}}}`, id, id)
+	}))
+	client := fivetran.New("key", "secret")
+	client.BaseURL(srv.URL)
+	t.Cleanup(srv.Close)
+	return client
+}
+
+const detailsConcurrency = 4
+
+func TestListConnectionDetails_KeepsOrderAndBoundsConcurrency(t *testing.T) {
+	t.Parallel()
+	var inFlight, maxInFlight, calls atomic.Int32
+	client := newConnectionDetailsServer(t, &inFlight, &maxInFlight, &calls, "")
+
+	ids := make([]string, 3*detailsConcurrency)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("connection_%v", i)
+	}
+
+	details, err := ListConnectionDetails(context.Background(), client, ids, detailsConcurrency)
+	if err != nil {
+		t.Fatalf("ListConnectionDetails() error = %v", err)
+	}
+	if len(details) != len(ids) {
+		t.Fatalf("ListConnectionDetails() returned %d details, want %d", len(details), len(ids))
+	}
+	for i, d := range details {
+		if d.Data.ID != ids[i] || d.Data.Config["host"] != ids[i]+".example.com" {
+			t.Errorf("details[%d] = %v (%v), want %v", i, d.Data.ID, d.Data.Config, ids[i])
+		}
+	}
+	if int(calls.Load()) != len(ids) {
+		t.Errorf("expected %d requests, got %d", len(ids), calls.Load())
+	}
+	if maxInFlight.Load() > detailsConcurrency {
+		t.Errorf("expected at most %d parallel requests, got %d", detailsConcurrency, maxInFlight.Load())
+	}
+}
+
+func TestListConnectionDetails_FailureCancelsRemainingRequests(t *testing.T) {
+	t.Parallel()
+	var inFlight, maxInFlight, calls atomic.Int32
+	client := newConnectionDetailsServer(t, &inFlight, &maxInFlight, &calls, "connection_0")
+
+	ids := make([]string, 10*detailsConcurrency)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("connection_%v", i)
+	}
+
+	_, err := ListConnectionDetails(context.Background(), client, ids, detailsConcurrency)
+	if err == nil || !strings.Contains(err.Error(), "connection connection_0") || !strings.Contains(err.Error(), "NotFound_Connection") {
+		t.Fatalf("ListConnectionDetails() error = %v, want the error of connection_0", err)
+	}
+	if int(calls.Load()) >= len(ids) {
+		t.Errorf("expected the remaining requests to be cancelled, got %d requests", calls.Load())
+	}
+}
+
+func TestListConnectionDetails_NoIds(t *testing.T) {
+	t.Parallel()
+	details, err := ListConnectionDetails(context.Background(), fivetran.New("key", "secret"), nil, detailsConcurrency)
+	if err != nil || len(details) != 0 {
+		t.Errorf("ListConnectionDetails(nil) = %v, %v", details, err)
+	}
+}
+
+func TestProviderDatasourceFetchConcurrency(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		providerData any
+		expected     int
+	}{
+		{&ProviderResourceData{Client: fivetran.New("key", "secret"), FetchConcurrency: 2}, 2},
+		{&ProviderResourceData{Client: fivetran.New("key", "secret")}, configSchema.DefaultColumnFetchConcurrency},
+		{fivetran.New("key", "secret"), configSchema.DefaultColumnFetchConcurrency},
+	} {
+		var d ProviderDatasource
+		d.Configure(context.Background(), datasource.ConfigureRequest{ProviderData: tc.providerData}, &datasource.ConfigureResponse{})
+		if actual := d.GetFetchConcurrency(); actual != tc.expected {
+			t.Errorf("GetFetchConcurrency() with %T = %v, want %v", tc.providerData, actual, tc.expected)
+		}
+	}
+}
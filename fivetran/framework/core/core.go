@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/fivetran/go-fivetran"
+	configSchema "github.com/fivetran/terraform-provider-fivetran/modules/connector/schema"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -27,6 +28,11 @@ type clientContainer struct {
 	client                 *fivetran.Client
 	metadataCache          *sync.Map
 	skipPlanTimeValidation bool
+	fieldStatusPolicy      string
+	failOnSetupTestFailure bool
+	columnFetcher          *configSchema.ColumnFetcher
+	fetchConcurrency       int
+	tableOwnership         *configSchema.TableOwnership
 }
 
 type ProviderDatasource struct {
@@ -53,6 +59,34 @@ func (d *clientContainer) GetSkipPlanTimeValidation() bool {
 	return d.skipPlanTimeValidation
 }
 
+func (d *clientContainer) GetFieldStatusPolicy() string {
+	if d.fieldStatusPolicy == "" {
+		return FieldStatusPolicyWarn
+	}
+	return d.fieldStatusPolicy
+}
+
+func (d *clientContainer) GetFailOnSetupTestFailure() bool {
+	return d.failOnSetupTestFailure
+}
+
+func (d *clientContainer) GetColumnFetcher() *configSchema.ColumnFetcher {
+	return d.columnFetcher
+}
+
+// GetFetchConcurrency returns the maximum number of parallel requests of the bounded fetches, set by the provider
+// `fetch_concurrency` attribute (or its deprecated alias `schema_columns_fetch_concurrency`).
+func (d *clientContainer) GetFetchConcurrency() int {
+	if d.fetchConcurrency < 1 {
+		return configSchema.DefaultColumnFetchConcurrency
+	}
+	return d.fetchConcurrency
+}
+
+func (d *clientContainer) GetTableOwnership() *configSchema.TableOwnership {
+	return d.tableOwnership
+}
+
 func (d *ProviderAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
 	d.getClient(resp.Diagnostics, req.ProviderData)
 }
@@ -77,6 +111,11 @@ func (d *clientContainer) getClient(diag diag.Diagnostics, data any) {
 		d.client = v.Client
 		d.metadataCache = v.MetadataCache
 		d.skipPlanTimeValidation = v.SkipPlanTimeValidation
+		d.fieldStatusPolicy = v.FieldStatusPolicy
+		d.failOnSetupTestFailure = v.FailOnSetupTestFailure
+		d.columnFetcher = v.ColumnFetcher
+		d.fetchConcurrency = v.FetchConcurrency
+		d.tableOwnership = v.TableOwnership
 	default:
 		diag.AddError(
 			"Unexpected Resource Configure Type",
@@ -0,0 +1,134 @@
+package core
+
+import (
+	"context"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/connections"
+	"github.com/fivetran/go-fivetran/destinations"
+	"github.com/fivetran/go-fivetran/groups"
+	"github.com/fivetran/go-fivetran/teams"
+	"github.com/fivetran/go-fivetran/transformations"
+	"github.com/fivetran/go-fivetran/users"
+	"github.com/fivetran/go-fivetran/webhooks"
+)
+
+// ListPageLimit is the page size used to read the cursor paginated list endpoints.
+const ListPageLimit = 1000
+
+// ListAll reads all pages of a cursor paginated list endpoint. page reads the page at the cursor, "" for the first page,
+// and returns its items and the cursor of the next page. Returns the items of all pages, or the error of the failed page.
+func ListAll[T any](page func(cursor string) (items []T, nextCursor string, err error)) ([]T, error) {
+	var result []T
+	cursor := ""
+	for {
+		items, nextCursor, err := page(cursor)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, items...)
+		if nextCursor == "" {
+			return result, nil
+		}
+		cursor = nextCursor
+	}
+}
+
+// WithCursor sets the cursor of a list service, the first page is read without one.
+func WithCursor[S interface{ Cursor(string) S }](svc S, cursor string) S {
+	if cursor != "" {
+		return svc.Cursor(cursor)
+	}
+	return svc
+}
+
+// The List* functions read all pages of the list endpoints and return the items of all pages in one response.
+// On error the response of the failed page is returned, so the error code is available to the caller.
+
+// ListConnections reads all connections, optionally filtered by group id and schema name (empty values don't filter).
+func ListConnections(ctx context.Context, client *fivetran.Client, groupId, schemaName string) (connections.ConnectionsListResponse, error) {
+	var response connections.ConnectionsListResponse
+	items, err := ListAll(func(cursor string) (items []connections.DetailsResponseDataCommon, nextCursor string, err error) {
+		svc := WithCursor(client.NewConnectionsList().Limit(ListPageLimit), cursor)
+		if groupId != "" {
+			svc.GroupID(groupId)
+		}
+		if schemaName != "" {
+			svc.Schema(schemaName)
+		}
+		response, err = svc.Do(ctx)
+		return response.Data.Items, response.Data.NextCursor, err
+	})
+	response.Data.Items = items
+	return response, err
+}
+
+// ListGroups reads all groups of the account.
+func ListGroups(ctx context.Context, client *fivetran.Client) (groups.GroupsListResponse, error) {
+	var response groups.GroupsListResponse
+	items, err := ListAll(func(cursor string) (items []groups.GroupItem, nextCursor string, err error) {
+		response, err = WithCursor(client.NewGroupsList().Limit(ListPageLimit), cursor).Do(ctx)
+		return response.Data.Items, response.Data.NextCursor, err
+	})
+	response.Data.Items = items
+	return response, err
+}
+
+// ListDestinations reads all destinations of the account.
+func ListDestinations(ctx context.Context, client *fivetran.Client) (destinations.DestinationsListResponse, error) {
+	var response destinations.DestinationsListResponse
+	items, err := ListAll(func(cursor string) (items []destinations.DestinationDetailsBase, nextCursor string, err error) {
+		response, err = WithCursor(client.NewDestinationsList().Limit(ListPageLimit), cursor).Do(ctx)
+		return response.Data.Items, response.Data.NextCursor, err
+	})
+	response.Data.Items = items
+	return response, err
+}
+
+// ListUsers reads all users of the account.
+func ListUsers(ctx context.Context, client *fivetran.Client) (users.UsersListResponse, error) {
+	var response users.UsersListResponse
+	items, err := ListAll(func(cursor string) (items []users.UserDetailsData, nextCursor string, err error) {
+		response, err = WithCursor(client.NewUsersList().Limit(ListPageLimit), cursor).Do(ctx)
+		return response.Data.Items, response.Data.NextCursor, err
+	})
+	response.Data.Items = items
+	return response, err
+}
+
+// ListTeams reads all teams of the account.
+func ListTeams(ctx context.Context, client *fivetran.Client) (teams.TeamsListResponse, error) {
+	var response teams.TeamsListResponse
+	items, err := ListAll(func(cursor string) (items []teams.TeamData, nextCursor string, err error) {
+		response, err = WithCursor(client.NewTeamsList().Limit(ListPageLimit), cursor).Do(ctx)
+		return response.Data.Items, response.Data.NextCursor, err
+	})
+	response.Data.Items = items
+	return response, err
+}
+
+// ListWebhooks reads all webhooks of the account.
+func ListWebhooks(ctx context.Context, client *fivetran.Client) (webhooks.WebhookListResponse, error) {
+	var response webhooks.WebhookListResponse
+	items, err := ListAll(func(cursor string) (items []webhooks.WebhookCommonData, nextCursor string, err error) {
+		response, err = WithCursor(client.NewWebhookList().Limit(ListPageLimit), cursor).Do(ctx)
+		return response.Data.Items, response.Data.NextCursor, err
+	})
+	response.Data.Items = items
+	return response, err
+}
+
+// ListTransformations reads all transformations of the account. The item type of the response can't be named, so the
+// pages are collected and their items merged.
+func ListTransformations(ctx context.Context, client *fivetran.Client) (transformations.TransformationsListResponse, error) {
+	var response transformations.TransformationsListResponse
+	pages, err := ListAll(func(cursor string) (pages []transformations.TransformationsListResponse, nextCursor string, err error) {
+		response, err = WithCursor(client.NewTransformationsList().Limit(ListPageLimit), cursor).Do(ctx)
+		return []transformations.TransformationsListResponse{response}, response.Data.NextCursor, err
+	})
+	response.Data.Items = nil
+	for _, page := range pages {
+		response.Data.Items = append(response.Data.Items, page.Data.Items...)
+	}
+	return response, err
+}
@@ -0,0 +1,64 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	fivetran "github.com/fivetran/go-fivetran"
+)
+
+func TestListAll(t *testing.T) {
+	t.Parallel()
+
+	pages := map[string][]string{"": {"a", "b"}, "2": {"c"}, "3": {}}
+	next := map[string]string{"": "2", "2": "3"}
+	var cursors []string
+	items, err := ListAll(func(cursor string) ([]string, string, error) {
+		cursors = append(cursors, cursor)
+		return pages[cursor], next[cursor], nil
+	})
+	if err != nil || !reflect.DeepEqual(items, []string{"a", "b", "c"}) || !reflect.DeepEqual(cursors, []string{"", "2", "3"}) {
+		t.Errorf("ListAll() = %v, %v, read cursors %v", items, err, cursors)
+	}
+
+	failure := errors.New("failed")
+	items, err = ListAll(func(cursor string) ([]string, string, error) {
+		if cursor == "2" {
+			return nil, "", failure
+		}
+		return pages[cursor], next[cursor], nil
+	})
+	if err != failure || items != nil {
+		t.Errorf("ListAll() = %v, %v, want the error of the failed page", items, err)
+	}
+}
+
+func TestListGroupsReadsAllPages(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			fmt.Fprint(w, `{"code":"Success","data":{"items":[{"id":"group_1"}],"next_cursor":"page_2"}}`)
+		case "page_2":
+			fmt.Fprint(w, `{"code":"Success","data":{"items":[{"id":"group_2"}]}}`)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"code":"InvalidCursor","message":"Invalid cursor"}`)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	client := fivetran.New("key", "secret")
+	client.BaseURL(srv.URL)
+
+	response, err := ListGroups(context.Background(), client)
+	if err != nil || response.Code != "Success" || len(response.Data.Items) != 2 || response.Data.Items[1].ID != "group_2" {
+		t.Errorf("ListGroups() = %+v, %v", response, err)
+	}
+}
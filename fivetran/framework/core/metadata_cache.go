@@ -56,3 +56,31 @@ func connectorMetadataCacheValue(service string, value interface{}) (*metadata.C
 	}
 	return meta, nil
 }
+
+// connectionServiceCacheKey keys connection services in the metadata cache, apart from connector metadata keyed by service name.
+type connectionServiceCacheKey string
+
+// GetCachedConnectionService returns the service of the connection, reading the connection details once per provider instance.
+// The service of a connection never changes, errors are not cached.
+func GetCachedConnectionService(ctx context.Context, client *fivetran.Client, cache *sync.Map, connectionId string) (string, error) {
+	key := connectionServiceCacheKey(connectionId)
+	if cache != nil {
+		if v, ok := cache.Load(key); ok {
+			if service, ok := v.(string); ok {
+				return service, nil
+			}
+		}
+	}
+	if client == nil {
+		return "", fmt.Errorf("unconfigured Fivetran client")
+	}
+
+	details, err := client.NewConnectionDetails().ConnectionID(connectionId).Do(ctx)
+	if err != nil {
+		return "", err
+	}
+	if cache != nil {
+		cache.Store(key, details.Data.Service)
+	}
+	return details.Data.Service, nil
+}
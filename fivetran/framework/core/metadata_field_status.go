@@ -9,6 +9,13 @@ const (
 	FieldStatusSunset              = "sunset"
 )
 
+// Provider level policies for fields in a non-GA metadata field status.
+const (
+	FieldStatusPolicyWarn   = "warn"
+	FieldStatusPolicyError  = "error"
+	FieldStatusPolicyIgnore = "ignore"
+)
+
 func MetadataFieldStatus(prop *metadata.Property) string {
 	if prop == nil {
 		return ""
@@ -3,7 +3,7 @@ package model
 import (
     "fmt"
 
-    //gfcommon "github.com/fivetran/go-fivetran/common"
+    gfcommon "github.com/fivetran/go-fivetran/common"
     "github.com/fivetran/go-fivetran/connections"
     //"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
     "github.com/hashicorp/terraform-plugin-framework/attr"
@@ -33,6 +33,7 @@ type ConnectionResourceModel struct {
     RunSetupTests     types.Bool `tfsdk:"run_setup_tests"`
     TrustCertificates types.Bool `tfsdk:"trust_certificates"`
     TrustFingerprints types.Bool `tfsdk:"trust_fingerprints"`
+    AdoptExisting     types.Bool `tfsdk:"adopt_existing"`
 }
 
 func (d *ConnectionResourceModel) ReadFromResponse(resp connections.DetailsWithCustomConfigNoTestsResponse) {
@@ -200,41 +201,45 @@ func (d *ConnectionDatasourceModel) ReadFromResponse(resp connections.DetailsWit
 
     d.DestinationSchema = getDestinationSchemaValue(resp.Data.Service, resp.Data.Schema, d.DestinationSchema, false)
 
-    codeMessageAttrType := types.ObjectType{
-        AttrTypes: codeMessageAttrTypes,
-    }
+    d.Status = connectionStatusValue(resp.Data.Status)
+}
 
-    warns := []attr.Value{}
-    for _, w := range resp.Data.Status.Warnings {
-        warns = append(warns, readCommonResponse(w))
-    }
-    tasks := []attr.Value{}
-    for _, t := range resp.Data.Status.Tasks {
-        tasks = append(tasks, readCommonResponse(t))
+var connectionStatusAttrTypes = map[string]attr.Type{
+    "setup_state":        types.StringType,
+    "is_historical_sync": types.BoolType,
+    "sync_state":         types.StringType,
+    "update_state":       types.StringType,
+    "tasks":              types.SetType{ElemType: types.ObjectType{AttrTypes: codeMessageAttrTypes}},
+    "warnings":           types.SetType{ElemType: types.ObjectType{AttrTypes: codeMessageAttrTypes}},
+}
+
+// codeMessageSetValue converts the status tasks or warnings into a set of `code` and `message` objects.
+func codeMessageSetValue(items []gfcommon.CommonResponse) types.Set {
+    values := []attr.Value{}
+    for _, item := range items {
+        values = append(values, readCommonResponse(item))
     }
+    result, _ := types.SetValue(types.ObjectType{AttrTypes: codeMessageAttrTypes}, values)
+    return result
+}
 
-    wsV, _ := types.SetValue(codeMessageAttrType, warns)
-    tsV, _ := types.SetValue(codeMessageAttrType, tasks)
-
-    status, _ := types.ObjectValue(
-        map[string]attr.Type{
-            "setup_state":        types.StringType,
-            "is_historical_sync": types.BoolType,
-            "sync_state":         types.StringType,
-            "update_state":       types.StringType,
-            "tasks":              types.SetType{ElemType: codeMessageAttrType},
-            "warnings":           types.SetType{ElemType: codeMessageAttrType},
-        },
+// connectionStatusValue converts the connection status returned by the connection details into the `status` object.
+func connectionStatusValue(status connections.StatusResponse) types.Object {
+    wsV := codeMessageSetValue(status.Warnings)
+    tsV := codeMessageSetValue(status.Tasks)
+
+    result, _ := types.ObjectValue(
+        connectionStatusAttrTypes,
         map[string]attr.Value{
-            "setup_state":        types.StringValue(resp.Data.Status.SetupState),
-            "is_historical_sync": types.BoolPointerValue(resp.Data.Status.IsHistoricalSync),
-            "sync_state":         types.StringValue(resp.Data.Status.SyncState),
-            "update_state":       types.StringValue(resp.Data.Status.UpdateState),
+            "setup_state":        types.StringValue(status.SetupState),
+            "is_historical_sync": types.BoolPointerValue(status.IsHistoricalSync),
+            "sync_state":         types.StringValue(status.SyncState),
+            "update_state":       types.StringValue(status.UpdateState),
             "warnings":           wsV,
             "tasks":              tsV,
         },
     )
-    d.Status = status
+    return result
 }
 
 // GetDestinatonSchemaForConfig builds minimal config from destination_schema for connection creation
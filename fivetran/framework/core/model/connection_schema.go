@@ -0,0 +1,109 @@
+package model
+
+import (
+	"encoding/json"
+
+	"github.com/fivetran/go-fivetran/connections"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ConnectionSchema struct {
+	Id                   types.String `tfsdk:"id"`
+	SchemaChangeHandling types.String `tfsdk:"schema_change_handling"`
+	Schemas              types.Map    `tfsdk:"schemas"`
+	SchemasJson          types.String `tfsdk:"schemas_json"`
+}
+
+var connectionSchemaColumnAttrTypes = map[string]attr.Type{
+	"name_in_destination": types.StringType,
+	"enabled":             types.BoolType,
+	"hashed":              types.BoolType,
+	"is_primary_key":      types.BoolType,
+	"patch_allowed":       types.BoolType,
+	"lock_reason_code":    types.StringType,
+	"lock_reason":         types.StringType,
+}
+
+var connectionSchemaTableAttrTypes = map[string]attr.Type{
+	"name_in_destination":     types.StringType,
+	"enabled":                 types.BoolType,
+	"sync_mode":               types.StringType,
+	"supports_columns_config": types.BoolType,
+	"patch_allowed":           types.BoolType,
+	"lock_reason_code":        types.StringType,
+	"lock_reason":             types.StringType,
+	"columns":                 types.MapType{ElemType: types.ObjectType{AttrTypes: connectionSchemaColumnAttrTypes}},
+}
+
+var connectionSchemaSchemaAttrTypes = map[string]attr.Type{
+	"name_in_destination": types.StringType,
+	"enabled":             types.BoolType,
+	"tables":              types.MapType{ElemType: types.ObjectType{AttrTypes: connectionSchemaTableAttrTypes}},
+}
+
+func (d *ConnectionSchema) ReadFromResponse(resp connections.ConnectionSchemaDetailsResponse) error {
+	d.SchemaChangeHandling = types.StringValue(resp.Data.SchemaChangeHandling)
+
+	schemas := map[string]attr.Value{}
+	for sName, schema := range resp.Data.Schemas {
+		if schema == nil {
+			continue
+		}
+		schemas[sName], _ = types.ObjectValue(connectionSchemaSchemaAttrTypes, map[string]attr.Value{
+			"name_in_destination": types.StringPointerValue(schema.NameInDestination),
+			"enabled":             types.BoolPointerValue(schema.Enabled),
+			"tables":              connectionSchemaTablesFromResponse(schema.Tables),
+		})
+	}
+	d.Schemas, _ = types.MapValue(types.ObjectType{AttrTypes: connectionSchemaSchemaAttrTypes}, schemas)
+
+	schemasJson, err := json.Marshal(resp.Data.Schemas)
+	if err != nil {
+		return err
+	}
+	d.SchemasJson = types.StringValue(string(schemasJson))
+	return nil
+}
+
+func connectionSchemaTablesFromResponse(tables map[string]*connections.ConnectionSchemaConfigTableResponse) types.Map {
+	items := map[string]attr.Value{}
+	for tName, table := range tables {
+		if table == nil {
+			continue
+		}
+		items[tName], _ = types.ObjectValue(connectionSchemaTableAttrTypes, map[string]attr.Value{
+			"name_in_destination":     types.StringPointerValue(table.NameInDestination),
+			"enabled":                 types.BoolPointerValue(table.Enabled),
+			"sync_mode":               types.StringPointerValue(table.SyncMode),
+			"supports_columns_config": types.BoolPointerValue(table.SupportsColumnsConfig),
+			"patch_allowed":           types.BoolPointerValue(table.EnabledPatchSettings.Allowed),
+			"lock_reason_code":        types.StringPointerValue(table.EnabledPatchSettings.ReasonCode),
+			"lock_reason":             types.StringPointerValue(table.EnabledPatchSettings.Reason),
+			"columns":                 connectionSchemaColumnsFromResponse(table.Columns),
+		})
+	}
+	result, _ := types.MapValue(types.ObjectType{AttrTypes: connectionSchemaTableAttrTypes}, items)
+	return result
+}
+
+// connectionSchemaColumnsFromResponse is shared with the table columns data source, the columns endpoint returns the same column objects.
+func connectionSchemaColumnsFromResponse(columns map[string]*connections.ConnectionSchemaConfigColumnResponse) types.Map {
+	items := map[string]attr.Value{}
+	for cName, column := range columns {
+		if column == nil {
+			continue
+		}
+		items[cName], _ = types.ObjectValue(connectionSchemaColumnAttrTypes, map[string]attr.Value{
+			"name_in_destination": types.StringPointerValue(column.NameInDestination),
+			"enabled":             types.BoolPointerValue(column.Enabled),
+			"hashed":              types.BoolPointerValue(column.Hashed),
+			"is_primary_key":      types.BoolPointerValue(column.IsPrimaryKey),
+			"patch_allowed":       types.BoolPointerValue(column.EnabledPatchSettings.Allowed),
+			"lock_reason_code":    types.StringPointerValue(column.EnabledPatchSettings.ReasonCode),
+			"lock_reason":         types.StringPointerValue(column.EnabledPatchSettings.Reason),
+		})
+	}
+	result, _ := types.MapValue(types.ObjectType{AttrTypes: connectionSchemaColumnAttrTypes}, items)
+	return result
+}
@@ -0,0 +1,26 @@
+package model
+
+import (
+	"github.com/fivetran/go-fivetran/connections"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ConnectionStatus struct {
+	Id               types.String `tfsdk:"id"`
+	SetupState       types.String `tfsdk:"setup_state"`
+	SyncState        types.String `tfsdk:"sync_state"`
+	UpdateState      types.String `tfsdk:"update_state"`
+	IsHistoricalSync types.Bool   `tfsdk:"is_historical_sync"`
+	Tasks            types.Set    `tfsdk:"tasks"`
+	Warnings         types.Set    `tfsdk:"warnings"`
+}
+
+func (d *ConnectionStatus) ReadFromResponse(resp connections.DetailsWithCustomConfigNoTestsResponse) {
+	d.Id = types.StringValue(resp.Data.ID)
+	d.SetupState = types.StringValue(resp.Data.Status.SetupState)
+	d.SyncState = types.StringValue(resp.Data.Status.SyncState)
+	d.UpdateState = types.StringValue(resp.Data.Status.UpdateState)
+	d.IsHistoricalSync = types.BoolPointerValue(resp.Data.Status.IsHistoricalSync)
+	d.Tasks = codeMessageSetValue(resp.Data.Status.Tasks)
+	d.Warnings = codeMessageSetValue(resp.Data.Status.Warnings)
+}
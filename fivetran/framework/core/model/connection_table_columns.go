@@ -0,0 +1,21 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/fivetran/go-fivetran/connections"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ConnectionTableColumns struct {
+	Id           types.String `tfsdk:"id"`
+	ConnectionId types.String `tfsdk:"connection_id"`
+	Schema       types.String `tfsdk:"schema"`
+	Table        types.String `tfsdk:"table"`
+	Columns      types.Map    `tfsdk:"columns"`
+}
+
+func (d *ConnectionTableColumns) ReadFromResponse(resp connections.ConnectionColumnConfigListResponse) {
+	d.Id = types.StringValue(fmt.Sprintf("%v/%v/%v", d.ConnectionId.ValueString(), d.Schema.ValueString(), d.Table.ValueString()))
+	d.Columns = connectionSchemaColumnsFromResponse(resp.Data.Columns)
+}
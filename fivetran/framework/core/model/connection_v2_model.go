@@ -9,6 +9,7 @@ import (
 	"github.com/fivetran/go-fivetran/connections"
 	"github.com/fivetran/go-fivetran/metadata"
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -45,7 +46,13 @@ type ConnectionV2ResourceModel struct {
 	TrustCertificates types.Bool `tfsdk:"trust_certificates"`
 	TrustFingerprints types.Bool `tfsdk:"trust_fingerprints"`
 
+	FailOnSetupTestFailure types.Bool `tfsdk:"fail_on_setup_test_failure"`
+	RollbackOnFailure      types.Bool `tfsdk:"rollback_on_failure"`
+
 	Status types.Object `tfsdk:"status"`
+
+	WaitFor  types.Object   `tfsdk:"wait_for"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func ConnectionV2CodeMessageAttrTypes() map[string]attr.Type {
@@ -96,7 +103,11 @@ func ConnectionV2ResourceModelAttrTypes() map[string]attr.Type {
 		"run_setup_tests":            types.BoolType,
 		"trust_certificates":         types.BoolType,
 		"trust_fingerprints":         types.BoolType,
+		"fail_on_setup_test_failure": types.BoolType,
+		"rollback_on_failure":        types.BoolType,
 		"status":                     types.ObjectType{AttrTypes: ConnectionV2StatusAttrTypes()},
+		"wait_for":                   types.ObjectType{AttrTypes: ConnectionWaitForAttrTypes()},
+		"timeouts":                   timeouts.Type{ObjectType: types.ObjectType{AttrTypes: ConnectionCreateTimeoutsAttrTypes()}},
 	}
 }
 
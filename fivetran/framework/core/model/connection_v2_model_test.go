@@ -53,7 +53,11 @@ func TestConnectionV2ResourceModelTfsdkShape(t *testing.T) {
 		RunSetupTests:           types.BoolValue(false),
 		TrustCertificates:       types.BoolValue(false),
 		TrustFingerprints:       types.BoolValue(false),
+		FailOnSetupTestFailure:  types.BoolNull(),
+		RollbackOnFailure:       types.BoolNull(),
 		Status:                  types.ObjectNull(model.ConnectionV2StatusAttrTypes()),
+		WaitFor:                 types.ObjectNull(model.ConnectionWaitForAttrTypes()),
+		Timeouts:                model.ConnectionCreateTimeoutsNull(),
 	}
 
 	var object types.Object
@@ -0,0 +1,65 @@
+package model
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// ConnectionWaitFor is the `wait_for` setting of connection resources, conditions not configured are not awaited.
+type ConnectionWaitFor struct {
+	SetupStateConnected bool
+	InitialSyncComplete bool
+	Unpause             bool
+}
+
+type connectionWaitForModel struct {
+	SetupStateConnected types.Bool `tfsdk:"setup_state_connected"`
+	InitialSyncComplete types.Bool `tfsdk:"initial_sync_complete"`
+	Unpause             types.Bool `tfsdk:"unpause"`
+}
+
+func ConnectionWaitForAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"setup_state_connected": types.BoolType,
+		"initial_sync_complete": types.BoolType,
+		"unpause":               types.BoolType,
+	}
+}
+
+// ConnectionCreateTimeoutsAttrTypes are the attribute types of `timeouts` blocks with the `create` timeout only.
+func ConnectionCreateTimeoutsAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"create": types.StringType,
+	}
+}
+
+func ConnectionCreateTimeoutsNull() timeouts.Value {
+	return timeouts.Value{Object: types.ObjectNull(ConnectionCreateTimeoutsAttrTypes())}
+}
+
+// GetConnectionWaitFor reads the `wait_for` setting, initial sync can't complete on a paused connection, so it implies unpause.
+func GetConnectionWaitFor(ctx context.Context, value types.Object) (ConnectionWaitFor, diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() {
+		return ConnectionWaitFor{}, nil
+	}
+
+	var data connectionWaitForModel
+	diags := value.As(ctx, &data, basetypes.ObjectAsOptions{})
+	waitFor := ConnectionWaitFor{
+		SetupStateConnected: data.SetupStateConnected.ValueBool(),
+		InitialSyncComplete: data.InitialSyncComplete.ValueBool(),
+		Unpause:             data.Unpause.ValueBool(),
+	}
+	waitFor.Unpause = waitFor.Unpause || waitFor.InitialSyncComplete
+	return waitFor, diags
+}
+
+// IsSet returns true if there is anything to do after the connection is created.
+func (w ConnectionWaitFor) IsSet() bool {
+	return w.SetupStateConnected || w.InitialSyncComplete || w.Unpause
+}
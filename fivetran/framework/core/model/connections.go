@@ -2,6 +2,7 @@ package model
 
 import (
     "context"
+    "encoding/json"
     "fmt"
 
     "github.com/fivetran/go-fivetran/connections"
@@ -10,13 +11,17 @@ import (
 )
 
 type Connections struct {
-    Id            types.String `tfsdk:"id"` 
-    GroupId       types.String `tfsdk:"group_id"`
-    SchemaName    types.String `tfsdk:"schema_name"`
-    Connections   types.Set    `tfsdk:"connections"`
+    Id             types.String `tfsdk:"id"` 
+    GroupId        types.String `tfsdk:"group_id"`
+    SchemaName     types.String `tfsdk:"schema_name"`
+    IncludeDetails types.Bool   `tfsdk:"include_details"`
+    Connections    types.Set    `tfsdk:"connections"`
+    Filter         types.Object `tfsdk:"filter"`
 }
 
-func (d *Connections) ReadFromResponse(ctx context.Context, resp connections.ConnectionsListResponse) {
+// ReadFromResponse reads the listed connections, details holds the details of the listed connections in the same order
+// when `include_details` is set and is nil otherwise.
+func (d *Connections) ReadFromResponse(ctx context.Context, resp connections.ConnectionsListResponse, details []connections.DetailsWithCustomConfigNoTestsResponse) {
     elementAttrType := map[string]attr.Type{
         "id":                           types.StringType,
         "name":                         types.StringType,
@@ -38,6 +43,8 @@ func (d *Connections) ReadFromResponse(ctx context.Context, resp connections.Con
         "networking_method":            types.StringType,
         "hybrid_deployment_agent_id":   types.StringType,
         "private_link_id":              types.StringType,
+        "config":                       types.StringType,
+        "status":                       types.ObjectType{AttrTypes: connectionStatusAttrTypes},
     }
 
     if resp.Data.Items == nil {
@@ -46,7 +53,7 @@ func (d *Connections) ReadFromResponse(ctx context.Context, resp connections.Con
 
     items := []attr.Value{}
     
-    for _, v := range resp.Data.Items {
+    for i, v := range resp.Data.Items {
         item := map[string]attr.Value{}
         item["id"] = types.StringValue(v.ID)
         item["name"] = types.StringValue(v.Schema)
@@ -68,6 +75,15 @@ func (d *Connections) ReadFromResponse(ctx context.Context, resp connections.Con
         item["networking_method"] = types.StringValue(v.NetworkingMethod)
         item["hybrid_deployment_agent_id"] = types.StringValue(v.HybridDeploymentAgentId)
         item["private_link_id"] = types.StringValue(v.PrivateLinkId)
+        item["config"] = types.StringNull()
+        item["status"] = types.ObjectNull(connectionStatusAttrTypes)
+        if details != nil {
+            if details[i].Data.Config != nil {
+                config, _ := json.Marshal(details[i].Data.Config)
+                item["config"] = types.StringValue(string(config))
+            }
+            item["status"] = connectionStatusValue(details[i].Data.Status)
+        }
 
         objectValue, _ := types.ObjectValue(elementAttrType, item)
         items = append(items, objectValue)
@@ -145,10 +145,15 @@ type ConnectorResourceModel struct {
     Config   types.Object   `tfsdk:"config"`
     Auth     types.Object   `tfsdk:"auth"`
     Timeouts timeouts.Value `tfsdk:"timeouts"`
+    WaitFor  types.Object   `tfsdk:"wait_for"`
 
     RunSetupTests     types.Bool `tfsdk:"run_setup_tests"`
     TrustCertificates types.Bool `tfsdk:"trust_certificates"`
     TrustFingerprints types.Bool `tfsdk:"trust_fingerprints"`
+
+    FailOnSetupTestFailure types.Bool `tfsdk:"fail_on_setup_test_failure"`
+    RollbackOnFailure      types.Bool `tfsdk:"rollback_on_failure"`
+    AdoptExisting          types.Bool `tfsdk:"adopt_existing"`
 }
 
 func (d *ConnectorResourceModel) ReadFromResponse(resp connections.DetailsWithCustomConfigNoTestsResponse, isImporting bool) diag.Diagnostics {
@@ -166,10 +171,20 @@ func (d *ConnectorResourceModel) ReadFromCreateResponse(resp connections.Details
 }
 
 func (d *ConnectorResourceModel) GetConfigMap(nullOnNull bool) (map[string]interface{}, error) {
+    return d.getConfigMap(nullOnNull, false)
+}
+
+// GetValidationConfigMap returns the config map like GetConfigMap(true), except that values of numeric
+// fields which don't parse are kept as strings, so plan-time validation can report them.
+func (d *ConnectorResourceModel) GetValidationConfigMap() (map[string]interface{}, error) {
+    return d.getConfigMap(true, true)
+}
+
+func (d *ConnectorResourceModel) getConfigMap(nullOnNull, keepUnparsed bool) (map[string]interface{}, error) {
     if d.Config.IsNull() && nullOnNull {
         return nil, nil
     }
-    result := getValueFromAttrValue(d.Config, common.GetConfigFieldsMap(), nil, d.Service.ValueString()).(map[string]interface{})
+    result := attrValueToInterface(d.Config, common.GetConfigFieldsMap(), nil, d.Service.ValueString(), keepUnparsed).(map[string]interface{})
     serviceName := d.Service.ValueString()
     serviceFields, err := common.GetFieldsForService(serviceName)
     if err != nil {
@@ -181,6 +196,16 @@ func (d *ConnectorResourceModel) GetConfigMap(nullOnNull bool) (map[string]inter
 }
 
 func (d *ConnectorResourceModel) GetAuthMap(nullOnNull bool) (map[string]interface{}, error) {
+    return d.getAuthMap(nullOnNull, false)
+}
+
+// GetValidationAuthMap returns the auth map like GetAuthMap(true), except that values of numeric
+// fields which don't parse are kept as strings, so plan-time validation can report them.
+func (d *ConnectorResourceModel) GetValidationAuthMap() (map[string]interface{}, error) {
+    return d.getAuthMap(true, true)
+}
+
+func (d *ConnectorResourceModel) getAuthMap(nullOnNull, keepUnparsed bool) (map[string]interface{}, error) {
     if d.Auth.IsNull() && nullOnNull {
         return nil, nil
     }
@@ -188,7 +213,7 @@ func (d *ConnectorResourceModel) GetAuthMap(nullOnNull bool) (map[string]interfa
     serviceFields := common.GetAuthFieldsForService(serviceName)
     allFields := common.GetAuthFieldsMap()
 
-    result := getValueFromAttrValue(d.Auth, allFields, nil, serviceName).(map[string]interface{})
+    result := attrValueToInterface(d.Auth, allFields, nil, serviceName, keepUnparsed).(map[string]interface{})
     err := patchServiceSpecificFields(result, serviceName, serviceFields, allFields)
     return result, err
 }
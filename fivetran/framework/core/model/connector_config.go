@@ -344,6 +344,12 @@ func getValue(
 }
 
 func getValueFromAttrValue(av attr.Value, fieldsMap map[string]common.ConfigField, currentField *common.ConfigField, service string) interface{} {
+	return attrValueToInterface(av, fieldsMap, currentField, service, false)
+}
+
+// attrValueToInterface converts the attribute value to the API value. Strings of integer and float fields that don't
+// parse become 0, unless keepUnparsed is set: plan-time validation keeps them as is to report them.
+func attrValueToInterface(av attr.Value, fieldsMap map[string]common.ConfigField, currentField *common.ConfigField, service string, keepUnparsed bool) interface{} {
 	if v, ok := av.(basetypes.StringValue); ok {
 		if currentField != nil {
 			if t, ok := currentField.ItemType[service]; ok {
@@ -353,6 +359,9 @@ func getValueFromAttrValue(av attr.Value, fieldsMap map[string]common.ConfigFiel
 					}
 					res, err := strconv.Atoi(v.ValueString())
 					if err != nil {
+						if keepUnparsed {
+							return v.ValueString()
+						}
 						return int(0)
 					}
 					return res
@@ -363,6 +372,9 @@ func getValueFromAttrValue(av attr.Value, fieldsMap map[string]common.ConfigFiel
 					}
 					res, err := strconv.ParseFloat(v.ValueString(), 64)
 					if err != nil {
+						if keepUnparsed {
+							return v.ValueString()
+						}
 						return float64(0)
 					}
 					return res
@@ -385,7 +397,7 @@ func getValueFromAttrValue(av attr.Value, fieldsMap map[string]common.ConfigFiel
 				if scf, ok := fieldsMap[an+"_"+service]; ok {
 					cf = scf
 				}
-				value := getValueFromAttrValue(av, cf.ItemFields, &cf, service)
+				value := attrValueToInterface(av, cf.ItemFields, &cf, service, keepUnparsed)
 				if value != nil {
 					result[an] = value
 				}
@@ -396,7 +408,7 @@ func getValueFromAttrValue(av attr.Value, fieldsMap map[string]common.ConfigFiel
 	if v, ok := av.(basetypes.SetValue); ok {
 		result := make([]interface{}, 0)
 		for _, ev := range v.Elements() {
-			value := getValueFromAttrValue(ev, fieldsMap, currentField, service)
+			value := attrValueToInterface(ev, fieldsMap, currentField, service, keepUnparsed)
 			if value != nil {
 				result = append(result, value)
 			}
@@ -406,7 +418,7 @@ func getValueFromAttrValue(av attr.Value, fieldsMap map[string]common.ConfigFiel
 	if v, ok := av.(basetypes.ListValue); ok {
 		result := make([]interface{}, 0)
 		for _, ev := range v.Elements() {
-			value := getValueFromAttrValue(ev, fieldsMap, currentField, service)
+			value := attrValueToInterface(ev, fieldsMap, currentField, service, keepUnparsed)
 			if value != nil {
 				result = append(result, value)
 			}
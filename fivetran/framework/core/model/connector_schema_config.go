@@ -27,6 +27,31 @@ type ConnectorSchemaResourceModel struct {
 	Timeouts             timeouts.Value                `tfsdk:"timeouts"`
 	SchemasRaw           fivetrantypes.JsonSchemaValue `tfsdk:"schemas_json"`
 	ValidationLevel      types.String                  `tfsdk:"validation_level"`
+	Rules                types.List                    `tfsdk:"rule"`
+	ResolvedRules        types.Map                     `tfsdk:"resolved_rules"`
+	MaxTablesPerRequest  types.Int64                   `tfsdk:"max_tables_per_request"`
+	OnDestroy            types.String                  `tfsdk:"on_destroy"`
+	ResyncOnChange       types.Bool                    `tfsdk:"resync_on_change"`
+}
+
+// GetOnDestroyPolicy returns the schema change handling policy the schema config is reset to on destroy,
+// empty string means the schema config is retained.
+func (d *ConnectorSchemaResourceModel) GetOnDestroyPolicy() string {
+	switch d.OnDestroy.ValueString() {
+	case "block_all":
+		return "BLOCK_ALL"
+	case "reset_to_allow_all":
+		return "ALLOW_ALL"
+	}
+	return ""
+}
+
+// GetMaxTablesPerRequest returns the limit of updated tables in a single schema config patch request, 0 means no limit.
+func (d *ConnectorSchemaResourceModel) GetMaxTablesPerRequest() int {
+	if d.MaxTablesPerRequest.IsNull() || d.MaxTablesPerRequest.IsUnknown() {
+		return 0
+	}
+	return int(d.MaxTablesPerRequest.ValueInt64())
 }
 
 func (d *ConnectorSchemaResourceModel) IsValid() bool {
@@ -49,7 +74,7 @@ func (d *ConnectorSchemaResourceModel) getValidationLevels() (bool, bool) {
 	return validateTables, validateColumns
 }
 
-func (d *ConnectorSchemaResourceModel) ValidateSchemaElements(response connections.ConnectionSchemaDetailsResponse, forceValidateColumns bool, client fivetran.Client, ctx context.Context) (error, bool) {
+func (d *ConnectorSchemaResourceModel) ValidateSchemaElements(response connections.ConnectionSchemaDetailsResponse, forceValidateColumns bool, client fivetran.Client, ctx context.Context, fetcher *configSchema.ColumnFetcher) (error, bool) {
 	validateTables, validateColumns := d.getValidationLevels()
 	if validateTables {
 		return d.GetSchemaConfig().ValidateSchemas(
@@ -57,11 +82,24 @@ func (d *ConnectorSchemaResourceModel) ValidateSchemaElements(response connectio
 			response.Data.Schemas,
 			client,
 			ctx,
-			validateColumns || forceValidateColumns)
+			validateColumns || forceValidateColumns,
+			fetcher)
 	}
 	return nil, false
 }
 
+// CheckConfiguredElements verifies configured schema elements and rules against the upstream schema config,
+// returns errors for changes of locked elements and for disabled or hashed primary key columns.
+func (d *ConnectorSchemaResourceModel) CheckConfiguredElements(response connections.ConnectionSchemaDetailsResponse) []error {
+	upstream := configSchema.SchemaConfig{}
+	upstream.ReadFromResponse(response)
+	var rules []configSchema.Rule
+	if d.IsRulesDefined() && !d.HasUnknownRules() {
+		rules = d.GetRules()
+	}
+	return upstream.CheckConfiguredElements(d.GetConfiguredSchemas(), rules)
+}
+
 func (d *ConnectorSchemaResourceModel) IsRawSchemaDefined() bool {
 	return !d.SchemasRaw.IsUnknown() && !d.SchemasRaw.IsNull() && len(d.SchemasRaw.ValueString()) > 0
 }
@@ -78,6 +116,7 @@ func (d *ConnectorSchemaResourceModel) ReadFromResponse(response connections.Con
 	schemaObject := configSchema.SchemaConfig{}
 	schemaObject.ReadFromResponse(response)
 	schemas := schemaObject.GetSchemas(response.Data.SchemaChangeHandling, d.GetSchemaConfig(), isImporting, diag)
+	d.ResolvedRules = d.getResolvedRules(schemaObject)
 
 	if d.IsLegacySchemaDefined() {
 		d.Schema = d.getLegacySchemaItems(schemas)
@@ -175,10 +214,75 @@ func (d *ConnectorSchemaResourceModel) GetSchemaConfig() configSchema.SchemaConf
 	if d.IsRawSchemaDefined() {
 		result.ReadFromRawSourceData(d.getSchemasRaw(), d.SchemaChangeHandling.ValueString())
 	}
+	if d.IsRulesDefined() {
+		result.SetRules(d.GetRules())
+	}
 
 	return result
 }
 
+// GetConfiguredSchemas returns schema elements in resource data format with explicitly set values only,
+// values that are not set or not known yet are omitted.
+func (d *ConnectorSchemaResourceModel) GetConfiguredSchemas() []interface{} {
+	if d.IsLegacySchemaDefined() {
+		return d.getLegacySchemas()
+	}
+	if d.IsMappedSchemaDefined() {
+		return d.getConfiguredMappedSchemas()
+	}
+	if d.IsRawSchemaDefined() {
+		return d.getSchemasRaw()
+	}
+	return []interface{}{}
+}
+
+func (d *ConnectorSchemaResourceModel) getConfiguredMappedSchemas() []interface{} {
+	setKnownValue := func(target map[string]interface{}, key string, attributes map[string]attr.Value) {
+		if value, ok := attributes[key].(basetypes.BoolValue); ok && !value.IsUnknown() && !value.IsNull() {
+			target[key] = value.ValueBool()
+		}
+	}
+	schemas := []interface{}{}
+	for sName, se := range d.Schemas.Elements() {
+		schemaElement, ok := se.(basetypes.ObjectValue)
+		if !ok || schemaElement.IsUnknown() {
+			continue
+		}
+		schema := map[string]interface{}{"name": sName}
+		setKnownValue(schema, "enabled", schemaElement.Attributes())
+
+		tables := []interface{}{}
+		tablesMap, _ := schemaElement.Attributes()["tables"].(basetypes.MapValue)
+		for tName, te := range tablesMap.Elements() {
+			tableElement, ok := te.(basetypes.ObjectValue)
+			if !ok || tableElement.IsUnknown() {
+				continue
+			}
+			table := map[string]interface{}{"name": tName}
+			setKnownValue(table, "enabled", tableElement.Attributes())
+
+			columns := []interface{}{}
+			columnsMap, _ := tableElement.Attributes()["columns"].(basetypes.MapValue)
+			for cName, ce := range columnsMap.Elements() {
+				columnElement, ok := ce.(basetypes.ObjectValue)
+				if !ok || columnElement.IsUnknown() {
+					continue
+				}
+				column := map[string]interface{}{"name": cName}
+				setKnownValue(column, "enabled", columnElement.Attributes())
+				setKnownValue(column, "hashed", columnElement.Attributes())
+				setKnownValue(column, "is_primary_key", columnElement.Attributes())
+				columns = append(columns, column)
+			}
+			table["column"] = columns
+			tables = append(tables, table)
+		}
+		schema["table"] = tables
+		schemas = append(schemas, schema)
+	}
+	return schemas
+}
+
 func (d *ConnectorSchemaResourceModel) getSchemasRawValue(schemas []interface{}) string {
 	result := mapRawSchemas(schemas)
 	resultRawString, _ := json.Marshal(result)
@@ -0,0 +1,98 @@
+package model
+
+import (
+	"github.com/fivetran/go-fivetran/connections"
+	configSchema "github.com/fivetran/terraform-provider-fivetran/modules/connector/schema"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func resolvedRuleAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"enabled":   types.BoolType,
+		"hashed":    types.BoolType,
+		"sync_mode": types.StringType,
+	}
+}
+
+func ResolvedRulesNull() basetypes.MapValue {
+	return types.MapNull(types.ObjectType{AttrTypes: resolvedRuleAttrTypes()})
+}
+
+func (d *ConnectorSchemaResourceModel) IsRulesDefined() bool {
+	return !d.Rules.IsUnknown() && !d.Rules.IsNull() && len(d.Rules.Elements()) > 0
+}
+
+// HasUnknownRules reports whether any rule value is not known yet, so rules can't be validated or resolved.
+func (d *ConnectorSchemaResourceModel) HasUnknownRules() bool {
+	if d.Rules.IsUnknown() {
+		return true
+	}
+	for _, re := range d.Rules.Elements() {
+		ruleElement, ok := re.(basetypes.ObjectValue)
+		if !ok || ruleElement.IsUnknown() {
+			return true
+		}
+		for _, v := range ruleElement.Attributes() {
+			if v.IsUnknown() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// GetRules returns configured rules in the order of `rule` blocks.
+func (d *ConnectorSchemaResourceModel) GetRules() []configSchema.Rule {
+	rules := []configSchema.Rule{}
+	for _, re := range d.Rules.Elements() {
+		ruleElement, ok := re.(basetypes.ObjectValue)
+		if !ok {
+			continue
+		}
+		attributes := ruleElement.Attributes()
+		rule := configSchema.Rule{
+			MatchType: attributes["match_type"].(basetypes.StringValue).ValueString(),
+			Schema:    attributes["schema"].(basetypes.StringValue).ValueString(),
+			Table:     attributes["table"].(basetypes.StringValue).ValueString(),
+			Column:    attributes["column"].(basetypes.StringValue).ValueString(),
+			Enabled:   attributes["enabled"].(basetypes.BoolValue).ValueBoolPointer(),
+			Hashed:    attributes["hashed"].(basetypes.BoolValue).ValueBoolPointer(),
+			SyncMode:  attributes["sync_mode"].(basetypes.StringValue).ValueStringPointer(),
+		}
+		if rule.MatchType == "" {
+			rule.MatchType = configSchema.REGEX
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// ResolveRules returns `resolved_rules` value for the upstream schema config from the response.
+func (d *ConnectorSchemaResourceModel) ResolveRules(response connections.ConnectionSchemaDetailsResponse) basetypes.MapValue {
+	upstream := configSchema.SchemaConfig{}
+	upstream.ReadFromResponse(response)
+	return d.getResolvedRules(upstream)
+}
+
+func (d *ConnectorSchemaResourceModel) getResolvedRules(upstream configSchema.SchemaConfig) basetypes.MapValue {
+	if !d.IsRulesDefined() || d.HasUnknownRules() {
+		return ResolvedRulesNull()
+	}
+	effects, err := upstream.ResolveRules(d.GetSchemaConfig())
+	if err != nil {
+		// invalid patterns are reported on config validation
+		return ResolvedRulesNull()
+	}
+	items := map[string]attr.Value{}
+	for k, v := range effects {
+		items[k], _ = types.ObjectValue(resolvedRuleAttrTypes(), map[string]attr.Value{
+			"enabled":   types.BoolPointerValue(v.Enabled),
+			"hashed":    types.BoolPointerValue(v.Hashed),
+			"sync_mode": types.StringPointerValue(v.SyncMode),
+		})
+	}
+	result, _ := types.MapValue(types.ObjectType{AttrTypes: resolvedRuleAttrTypes()}, items)
+	return result
+}
@@ -0,0 +1,122 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/connections"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type ConnectorSchemaTableResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	ConnectionId types.String `tfsdk:"connection_id"`
+	Schema       types.String `tfsdk:"schema"`
+	Table        types.String `tfsdk:"table"`
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	SyncMode     types.String `tfsdk:"sync_mode"`
+	Columns      types.Map    `tfsdk:"columns"`
+}
+
+func schemaTableColumnAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"enabled":        types.BoolType,
+		"hashed":         types.BoolType,
+		"is_primary_key": types.BoolType,
+	}
+}
+
+// ParseConnectorSchemaTableId splits the resource id in `connection_id/schema/table` format. Schema names can't contain `/`,
+// so everything after the second `/` is the table name.
+func ParseConnectorSchemaTableId(id string) (connectionId, schema, table string, err error) {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("expected id in `connection_id/schema/table` format, got: %q", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// PrepareRequest fills the table config update request with configured values, values that are not known yet are skipped.
+func (d *ConnectorSchemaTableResourceModel) PrepareRequest(svc *connections.ConnectionTableConfigUpdateService) *connections.ConnectionTableConfigUpdateService {
+	svc.ConnectionId(d.ConnectionId.ValueString())
+	svc.Schema(d.Schema.ValueString())
+	svc.Table(d.Table.ValueString())
+	if !d.Enabled.IsNull() && !d.Enabled.IsUnknown() {
+		svc.Enabled(d.Enabled.ValueBool())
+	}
+	if !d.SyncMode.IsNull() && !d.SyncMode.IsUnknown() {
+		svc.SyncMode(d.SyncMode.ValueString())
+	}
+	for cName, ce := range d.Columns.Elements() {
+		columnElement, ok := ce.(basetypes.ObjectValue)
+		if !ok {
+			continue
+		}
+		column := fivetran.NewConnectionSchemaConfigColumn()
+		if enabled, ok := columnElement.Attributes()["enabled"].(basetypes.BoolValue); ok && !enabled.IsNull() && !enabled.IsUnknown() {
+			column.Enabled(enabled.ValueBool())
+		}
+		if hashed, ok := columnElement.Attributes()["hashed"].(basetypes.BoolValue); ok && !hashed.IsNull() && !hashed.IsUnknown() {
+			column.Hashed(hashed.ValueBool())
+		}
+		svc.Columns(cName, column)
+	}
+	return svc
+}
+
+// ReadFromResponse reads the table from the connection schema details, returns false if the table doesn't exist.
+// Only configured columns are read, so columns managed by other means don't cause drift.
+func (d *ConnectorSchemaTableResourceModel) ReadFromResponse(response connections.ConnectionSchemaDetailsResponse) bool {
+	schema, ok := response.Data.Schemas[d.Schema.ValueString()]
+	if !ok || schema == nil {
+		return false
+	}
+	table, ok := schema.Tables[d.Table.ValueString()]
+	if !ok || table == nil {
+		return false
+	}
+
+	d.Id = types.StringValue(fmt.Sprintf("%v/%v/%v", d.ConnectionId.ValueString(), d.Schema.ValueString(), d.Table.ValueString()))
+	d.Enabled = types.BoolPointerValue(table.Enabled)
+	d.SyncMode = types.StringPointerValue(table.SyncMode)
+
+	if d.Columns.IsNull() || d.Columns.IsUnknown() {
+		d.Columns = types.MapNull(types.ObjectType{AttrTypes: schemaTableColumnAttrTypes()})
+		return true
+	}
+
+	columns := make(map[string]attr.Value)
+	for cName, ce := range d.Columns.Elements() {
+		local, _ := ce.(basetypes.ObjectValue)
+		enabled := localBoolValue(local, "enabled", true)
+		hashed := localBoolValue(local, "hashed", false)
+		isPrimaryKey := types.BoolNull()
+		if upstream, ok := table.Columns[cName]; ok && upstream != nil {
+			if upstream.Enabled != nil {
+				enabled = types.BoolPointerValue(upstream.Enabled)
+			}
+			if upstream.Hashed != nil {
+				hashed = types.BoolPointerValue(upstream.Hashed)
+			}
+			isPrimaryKey = types.BoolPointerValue(upstream.IsPrimaryKey)
+		}
+		columns[cName], _ = types.ObjectValue(schemaTableColumnAttrTypes(), map[string]attr.Value{
+			"enabled":        enabled,
+			"hashed":         hashed,
+			"is_primary_key": isPrimaryKey,
+		})
+	}
+	d.Columns, _ = types.MapValue(types.ObjectType{AttrTypes: schemaTableColumnAttrTypes()}, columns)
+	return true
+}
+
+// localBoolValue returns the configured value, or the default one if the value is not configured or not known yet.
+func localBoolValue(local basetypes.ObjectValue, name string, defaultValue bool) types.Bool {
+	if value, ok := local.Attributes()[name].(basetypes.BoolValue); ok && !value.IsNull() && !value.IsUnknown() {
+		return value
+	}
+	return types.BoolValue(defaultValue)
+}
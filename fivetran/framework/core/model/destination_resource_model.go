@@ -26,6 +26,9 @@ type DestinationResourceModel struct {
 	RunSetupTests     types.Bool `tfsdk:"run_setup_tests"`
 	TrustCertificates types.Bool `tfsdk:"trust_certificates"`
 	TrustFingerprints types.Bool `tfsdk:"trust_fingerprints"`
+
+	FailOnSetupTestFailure types.Bool `tfsdk:"fail_on_setup_test_failure"`
+	RollbackOnFailure      types.Bool `tfsdk:"rollback_on_failure"`
 }
 
 var _ destinationModel = &DestinationResourceModel{}
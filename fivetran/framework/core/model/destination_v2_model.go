@@ -0,0 +1,95 @@
+package model
+
+import (
+	"context"
+
+	"github.com/fivetran/go-fivetran/destinations"
+	"github.com/fivetran/go-fivetran/metadata"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DestinationV2ResourceModel struct {
+	Id                        types.String `tfsdk:"id"`
+	GroupId                   types.String `tfsdk:"group_id"`
+	Service                   types.String `tfsdk:"service"`
+	Region                    types.String `tfsdk:"region"`
+	TimeZoneOffset            types.String `tfsdk:"time_zone_offset"`
+	SetupStatus               types.String `tfsdk:"setup_status"`
+	DaylightSavingTimeEnabled types.Bool   `tfsdk:"daylight_saving_time_enabled"`
+
+	Config types.Dynamic `tfsdk:"config"`
+
+	HybridDeploymentAgentId types.String `tfsdk:"hybrid_deployment_agent_id"`
+	NetworkingMethod        types.String `tfsdk:"networking_method"`
+	PrivateLinkId           types.String `tfsdk:"private_link_id"`
+	ProxyAgentId            types.String `tfsdk:"proxy_agent_id"`
+
+	RunSetupTests          types.Bool `tfsdk:"run_setup_tests"`
+	TrustCertificates      types.Bool `tfsdk:"trust_certificates"`
+	TrustFingerprints      types.Bool `tfsdk:"trust_fingerprints"`
+	FailOnSetupTestFailure types.Bool `tfsdk:"fail_on_setup_test_failure"`
+	RollbackOnFailure      types.Bool `tfsdk:"rollback_on_failure"`
+}
+
+func DestinationV2ResourceModelAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                           types.StringType,
+		"group_id":                     types.StringType,
+		"service":                      types.StringType,
+		"region":                       types.StringType,
+		"time_zone_offset":             types.StringType,
+		"setup_status":                 types.StringType,
+		"daylight_saving_time_enabled": types.BoolType,
+		"config":                       types.DynamicType,
+		"hybrid_deployment_agent_id":   types.StringType,
+		"networking_method":            types.StringType,
+		"private_link_id":              types.StringType,
+		"proxy_agent_id":               types.StringType,
+		"run_setup_tests":              types.BoolType,
+		"trust_certificates":           types.BoolType,
+		"trust_fingerprints":           types.BoolType,
+		"fail_on_setup_test_failure":   types.BoolType,
+		"rollback_on_failure":          types.BoolType,
+	}
+}
+
+func (d *DestinationV2ResourceModel) ReadFromCreateResponse(ctx context.Context, resp destinations.DestinationDetailsWithSetupTestsCustomResponse, slot *metadata.Property, configMask map[string]interface{}) diag.Diagnostics {
+	return d.readFromResponseData(ctx, resp.Data.DestinationDetailsBase, resp.Data.Config, slot, configMask)
+}
+
+func (d *DestinationV2ResourceModel) ReadFromResponse(ctx context.Context, resp destinations.DestinationDetailsCustomResponse, slot *metadata.Property, configMask map[string]interface{}) diag.Diagnostics {
+	return d.readFromResponseData(ctx, resp.Data.DestinationDetailsBase, resp.Data.Config, slot, configMask)
+}
+
+func (d *DestinationV2ResourceModel) ReadFromResponseForImport(ctx context.Context, resp destinations.DestinationDetailsCustomResponse, slot *metadata.Property) diag.Diagnostics {
+	return d.readFromResponseData(ctx, resp.Data.DestinationDetailsBase, resp.Data.Config, slot, resp.Data.Config)
+}
+
+func (d *DestinationV2ResourceModel) readFromResponseData(ctx context.Context, data destinations.DestinationDetailsBase, config map[string]interface{}, slot *metadata.Property, configMask map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.Id = types.StringValue(data.ID)
+	d.GroupId = types.StringValue(data.GroupID)
+	d.Service = types.StringValue(data.Service)
+	d.Region = stringValueOrNull(data.Region)
+	d.TimeZoneOffset = stringValueOrNull(data.TimeZoneOffset)
+	d.SetupStatus = stringValueOrNull(data.SetupStatus)
+	d.DaylightSavingTimeEnabled = types.BoolValue(data.DaylightSavingTimeEnabled)
+
+	d.HybridDeploymentAgentId = stringValueOrNull(data.HybridDeploymentAgentId)
+	d.NetworkingMethod = stringValueOrNull(data.NetworkingMethod)
+	d.PrivateLinkId = stringValueOrNull(data.PrivateLinkId)
+	d.ProxyAgentId = stringValueOrNull(data.ProxyAgentId)
+
+	projectedConfig := core.ProjectDynamic(config, configMask, slot)
+	dynamicConfig, dynamicDiags := core.MapToDynamic(ctx, projectedConfig)
+	diags.Append(dynamicDiags...)
+	if !diags.HasError() {
+		d.Config = dynamicConfig
+	}
+
+	return diags
+}
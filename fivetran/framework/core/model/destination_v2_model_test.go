@@ -0,0 +1,69 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fivetran/go-fivetran/destinations"
+	"github.com/fivetran/go-fivetran/metadata"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+)
+
+func TestDestinationV2ReadFromResponseProjectsConfig(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var response destinations.DestinationDetailsCustomResponse
+	response.Data.ID = "destination_id"
+	response.Data.GroupID = "destination_id"
+	response.Data.Service = "snowflake"
+	response.Data.TimeZoneOffset = "0"
+	response.Data.SetupStatus = "connected"
+	response.Data.Config = map[string]interface{}{
+		"host":       "account.snowflakecomputing.com",
+		"password":   "******",
+		"public_key": "ssh-rsa AAAA",
+		"role":       "unmanaged",
+	}
+
+	slot := &metadata.Property{Properties: map[string]*metadata.Property{
+		"host":       {Type: "string"},
+		"password":   {Type: "string", Format: "password"},
+		"public_key": {Type: "string", Readonly: true},
+		"role":       {Type: "string"},
+		"database":   {Type: "string"},
+	}}
+	mask := map[string]interface{}{
+		"host":     "account.snowflakecomputing.com",
+		"password": "secret",
+		"database": "removed_upstream",
+	}
+
+	var data model.DestinationV2ResourceModel
+	diags := data.ReadFromResponse(ctx, response, slot, mask)
+	if diags.HasError() {
+		t.Fatalf("ReadFromResponse diagnostics: %v", diags)
+	}
+
+	config, diags := core.DynamicToMap(ctx, data.Config)
+	if diags.HasError() {
+		t.Fatalf("DynamicToMap diagnostics: %v", diags)
+	}
+
+	if config["password"] != "secret" {
+		t.Fatalf("password = %v, want the configured value", config["password"])
+	}
+	if config["public_key"] != "ssh-rsa AAAA" {
+		t.Fatalf("public_key = %v, want readonly remote value", config["public_key"])
+	}
+	if _, ok := config["role"]; ok {
+		t.Fatal("unmanaged fields must not be projected into state")
+	}
+	if v, ok := config["database"]; !ok || v != nil {
+		t.Fatalf("database = %v, want null to surface drift", v)
+	}
+	if data.SetupStatus.ValueString() != "connected" || !data.Region.IsNull() {
+		t.Fatalf("unexpected root fields: setup_status=%v region=%v", data.SetupStatus, data.Region)
+	}
+}
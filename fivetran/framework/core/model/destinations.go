@@ -11,6 +11,7 @@ import (
 type Destinations struct {
     Id              types.String `tfsdk:"id"` 
     Destinations    types.Set    `tfsdk:"destinations"`
+    Filter          types.Object `tfsdk:"filter"`
 }
 
 func (d *Destinations) ReadFromResponse(ctx context.Context, resp destinations.DestinationsListResponse) {
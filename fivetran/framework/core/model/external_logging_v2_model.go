@@ -0,0 +1,76 @@
+package model
+
+import (
+	"context"
+
+	externallogging "github.com/fivetran/go-fivetran/external_logging"
+	"github.com/fivetran/go-fivetran/metadata"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// maskedSecretValue is what the API returns in place of secret config values.
+const maskedSecretValue = "******"
+
+type ExternalLoggingV2ResourceModel struct {
+	Id            types.String  `tfsdk:"id"`
+	GroupId       types.String  `tfsdk:"group_id"`
+	Service       types.String  `tfsdk:"service"`
+	Enabled       types.Bool    `tfsdk:"enabled"`
+	Config        types.Dynamic `tfsdk:"config"`
+	RunSetupTests types.Bool    `tfsdk:"run_setup_tests"`
+}
+
+func ExternalLoggingV2ResourceModelAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":              types.StringType,
+		"group_id":        types.StringType,
+		"service":         types.StringType,
+		"enabled":         types.BoolType,
+		"config":          types.DynamicType,
+		"run_setup_tests": types.BoolType,
+	}
+}
+
+func (d *ExternalLoggingV2ResourceModel) ReadFromResponse(ctx context.Context, resp externallogging.ExternalLoggingCustomResponse, slot *metadata.Property, configMask map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.Id = types.StringValue(resp.Data.Id)
+	// The log service shares its id with the group it belongs to, the API does not return group_id.
+	if d.GroupId.IsNull() || d.GroupId.IsUnknown() {
+		d.GroupId = types.StringValue(resp.Data.Id)
+	}
+	d.Service = types.StringValue(resp.Data.Service)
+	d.Enabled = types.BoolValue(resp.Data.Enabled)
+
+	projectedConfig := core.ProjectDynamic(resp.Data.Config, configMask, slot)
+	preserveMaskedSecrets(projectedConfig, configMask)
+
+	dynamicConfig, dynamicDiags := core.MapToDynamic(ctx, projectedConfig)
+	diags.Append(dynamicDiags...)
+	if !diags.HasError() {
+		d.Config = dynamicConfig
+	}
+
+	return diags
+}
+
+func (d *ExternalLoggingV2ResourceModel) ReadFromResponseForImport(ctx context.Context, resp externallogging.ExternalLoggingCustomResponse, slot *metadata.Property) diag.Diagnostics {
+	return d.ReadFromResponse(ctx, resp, slot, resp.Data.Config)
+}
+
+// preserveMaskedSecrets keeps the configured value for fields the API returned masked.
+// Password fields described by the slot are already preserved by the projection; this covers
+// secrets of services that are newer than the bundled field definitions.
+func preserveMaskedSecrets(projected, mask map[string]interface{}) {
+	for key, value := range projected {
+		if value != maskedSecretValue {
+			continue
+		}
+		if local, ok := mask[key]; ok && local != nil {
+			projected[key] = local
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	externallogging "github.com/fivetran/go-fivetran/external_logging"
+	"github.com/fivetran/go-fivetran/metadata"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestExternalLoggingV2ReadFromResponseProjectsConfig(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var response externallogging.ExternalLoggingCustomResponse
+	response.Data.Id = "group_id"
+	response.Data.Service = "datadog_log"
+	response.Data.Enabled = true
+	response.Data.Config = map[string]interface{}{
+		"api_key":    "******",
+		"region":     "us",
+		"hostname":   "unmanaged",
+		"intake_url": "https://http-intake.logs.datadoghq.com",
+		"new_secret": "******",
+	}
+
+	slot := &metadata.Property{Properties: map[string]*metadata.Property{
+		"api_key":    {Type: "string", Format: "password"},
+		"region":     {Type: "string"},
+		"hostname":   {Type: "string"},
+		"intake_url": {Type: "string", Readonly: true},
+	}}
+	mask := map[string]interface{}{
+		"api_key":    "secret",
+		"region":     "us",
+		"new_secret": "another_secret",
+	}
+
+	data := model.ExternalLoggingV2ResourceModel{GroupId: types.StringValue("group_id")}
+	diags := data.ReadFromResponse(ctx, response, slot, mask)
+	if diags.HasError() {
+		t.Fatalf("ReadFromResponse diagnostics: %v", diags)
+	}
+
+	config, diags := core.DynamicToMap(ctx, data.Config)
+	if diags.HasError() {
+		t.Fatalf("DynamicToMap diagnostics: %v", diags)
+	}
+
+	if config["api_key"] != "secret" {
+		t.Fatalf("api_key = %v, want the configured value", config["api_key"])
+	}
+	if config["new_secret"] != "another_secret" {
+		t.Fatalf("new_secret = %v, masked values of fields unknown to the slot must keep the configured value", config["new_secret"])
+	}
+	if config["intake_url"] != "https://http-intake.logs.datadoghq.com" {
+		t.Fatalf("intake_url = %v, want readonly remote value", config["intake_url"])
+	}
+	if _, ok := config["hostname"]; ok {
+		t.Fatal("unmanaged fields must not be projected into state")
+	}
+	if !data.Enabled.ValueBool() || data.Service.ValueString() != "datadog_log" || data.GroupId.ValueString() != "group_id" {
+		t.Fatalf("unexpected root fields: enabled=%v service=%v group_id=%v", data.Enabled, data.Service, data.GroupId)
+	}
+}
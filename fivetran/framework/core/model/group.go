@@ -15,6 +15,12 @@ type Group struct {
     LastUpdated  types.String `tfsdk:"last_updated"`
 }
 
+// GroupResourceModel extends the group model shared with the data source by the resource-only attributes.
+type GroupResourceModel struct {
+    Group
+    AdoptExisting types.Bool `tfsdk:"adopt_existing"`
+}
+
 func (d *Group) ReadFromResponse(ctx context.Context, resp groups.GroupDetailsResponse) {
     d.Id = types.StringValue(resp.Data.ID)
     d.Name = types.StringValue(resp.Data.Name)
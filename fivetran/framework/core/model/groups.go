@@ -11,6 +11,7 @@ import (
 type Groups struct {
     Id       types.String `tfsdk:"id"` 
     Groups   types.Set    `tfsdk:"groups"`
+    Filter   types.Object `tfsdk:"filter"`
 }
 
 func (d *Groups) ReadFromResponse(ctx context.Context, resp groups.GroupsListResponse) {
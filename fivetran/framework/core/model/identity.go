@@ -0,0 +1,22 @@
+package model
+
+import (
+    "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// IdIdentity is the identity of resources identified by their id alone.
+type IdIdentity struct {
+    Id types.String `tfsdk:"id"`
+}
+
+// ConnectionIdentity is the identity of connections, `schema_name` is the `name` attribute of the connection resources.
+type ConnectionIdentity struct {
+    Id         types.String `tfsdk:"id"`
+    GroupId    types.String `tfsdk:"group_id"`
+    SchemaName types.String `tfsdk:"schema_name"`
+}
+
+// TeamMembershipIdentity is the identity of team memberships, the resources manage all memberships of one team.
+type TeamMembershipIdentity struct {
+    TeamId types.String `tfsdk:"team_id"`
+}
@@ -0,0 +1,181 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/fivetran/go-fivetran/connections"
+	"github.com/fivetran/go-fivetran/destinations"
+	"github.com/fivetran/go-fivetran/groups"
+	"github.com/fivetran/go-fivetran/teams"
+	"github.com/fivetran/go-fivetran/users"
+	"github.com/fivetran/go-fivetran/webhooks"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ListFilter holds the criteria of the `filter` block of the plural data sources which the list endpoints don't
+// support, they are applied to the listed items.
+type ListFilter struct {
+	service      string
+	setupState   string
+	role         string
+	paused       *bool
+	nameRegex    *regexp.Regexp
+	emailRegex   *regexp.Regexp
+	createdAfter *time.Time
+}
+
+// ListFilterFields are the values of a listed item the filter criteria are matched against.
+type ListFilterFields struct {
+	Service    string
+	SetupState string
+	Name       string
+	Email      string
+	Role       string
+	Paused     *bool
+	CreatedAt  time.Time
+}
+
+// NewListFilter reads the `filter` block, a null block matches all items.
+func NewListFilter(filter types.Object) (ListFilter, diag.Diagnostics) {
+	var result ListFilter
+	var diags diag.Diagnostics
+	if filter.IsNull() || filter.IsUnknown() {
+		return result, diags
+	}
+
+	for name, value := range filter.Attributes() {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		attributePath := path.Root("filter").AtName(name)
+
+		if paused, ok := value.(types.Bool); ok {
+			result.paused = paused.ValueBoolPointer()
+			continue
+		}
+		s, ok := value.(types.String)
+		if !ok {
+			continue
+		}
+
+		switch name {
+		case "service":
+			result.service = s.ValueString()
+		case "setup_state":
+			result.setupState = s.ValueString()
+		case "role":
+			result.role = s.ValueString()
+		case "name_regex", "email_regex":
+			re, err := regexp.Compile(s.ValueString())
+			if err != nil {
+				diags.AddAttributeError(attributePath, "Invalid regular expression.", err.Error())
+				continue
+			}
+			if name == "name_regex" {
+				result.nameRegex = re
+			} else {
+				result.emailRegex = re
+			}
+		case "created_after":
+			createdAfter, err := time.Parse(time.RFC3339, s.ValueString())
+			if err != nil {
+				diags.AddAttributeError(attributePath, "Invalid timestamp.",
+					fmt.Sprintf("Expected an RFC 3339 timestamp like `2024-01-01T00:00:00Z`: %v", err))
+				continue
+			}
+			result.createdAfter = &createdAfter
+		}
+	}
+	return result, diags
+}
+
+// Match reports whether the item values match all set criteria. Items without a value for a set criterion don't match.
+func (f ListFilter) Match(fields ListFilterFields) bool {
+	if f.service != "" && fields.Service != f.service {
+		return false
+	}
+	if f.setupState != "" && fields.SetupState != f.setupState {
+		return false
+	}
+	if f.role != "" && fields.Role != f.role {
+		return false
+	}
+	if f.paused != nil && (fields.Paused == nil || *fields.Paused != *f.paused) {
+		return false
+	}
+	if f.nameRegex != nil && (fields.Name == "" || !f.nameRegex.MatchString(fields.Name)) {
+		return false
+	}
+	if f.emailRegex != nil && (fields.Email == "" || !f.emailRegex.MatchString(fields.Email)) {
+		return false
+	}
+	if f.createdAfter != nil && (fields.CreatedAt.IsZero() || !fields.CreatedAt.After(*f.createdAfter)) {
+		return false
+	}
+	return true
+}
+
+// FilterList returns the items matching the filter in their original order, a nil list stays nil.
+func FilterList[T any](filter ListFilter, items []T, fields func(T) ListFilterFields) []T {
+	if items == nil {
+		return nil
+	}
+	result := make([]T, 0, len(items))
+	for _, item := range items {
+		if filter.Match(fields(item)) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+func ConnectionFilterFields(item connections.DetailsResponseDataCommon) ListFilterFields {
+	return ListFilterFields{
+		Service:    item.Service,
+		SetupState: item.Status.SetupState,
+		Name:       item.Schema,
+		Paused:     item.Paused,
+		CreatedAt:  item.CreatedAt,
+	}
+}
+
+func DestinationFilterFields(item destinations.DestinationDetailsBase) ListFilterFields {
+	return ListFilterFields{
+		Service:    item.Service,
+		SetupState: item.SetupStatus,
+	}
+}
+
+func GroupFilterFields(item groups.GroupItem) ListFilterFields {
+	return ListFilterFields{
+		Name:      item.Name,
+		CreatedAt: item.CreatedAt,
+	}
+}
+
+func UserFilterFields(item users.UserDetailsData) ListFilterFields {
+	return ListFilterFields{
+		Email:     item.Email,
+		Role:      item.Role,
+		CreatedAt: item.CreatedAt,
+	}
+}
+
+func TeamFilterFields(item teams.TeamData) ListFilterFields {
+	return ListFilterFields{
+		Name: item.Name,
+		Role: item.Role,
+	}
+}
+
+func WebhookFilterFields(item webhooks.WebhookCommonData) ListFilterFields {
+	// webhooks return the creation time as a string, unparsable values don't match `created_after`
+	createdAt, _ := time.Parse(time.RFC3339, item.CreatedAt)
+	return ListFilterFields{
+		CreatedAt: createdAt,
+	}
+}
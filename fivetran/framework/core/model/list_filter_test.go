@@ -0,0 +1,107 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fivetran/go-fivetran/connections"
+	"github.com/fivetran/go-fivetran/webhooks"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func listFilter(t *testing.T, values map[string]attr.Value) types.Object {
+	t.Helper()
+	attrTypes := map[string]attr.Type{}
+	for name, value := range values {
+		attrTypes[name] = value.Type(nil)
+	}
+	filter, diags := types.ObjectValue(attrTypes, values)
+	if diags.HasError() {
+		t.Fatalf("building filter: %v", diags)
+	}
+	return filter
+}
+
+func connection(id, service, schema, setupState string, paused bool, createdAt string) connections.DetailsResponseDataCommon {
+	item := connections.DetailsResponseDataCommon{ID: id, Service: service, Schema: schema, Paused: &paused}
+	item.Status.SetupState = setupState
+	item.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
+	return item
+}
+
+func TestFilterListConnections(t *testing.T) {
+	t.Parallel()
+
+	items := []connections.DetailsResponseDataCommon{
+		connection("pg_prod", "postgres", "pg_prod", "connected", false, "2024-03-01T00:00:00Z"),
+		connection("pg_paused", "postgres", "pg_staging", "connected", true, "2024-03-01T00:00:00Z"),
+		connection("pg_broken", "postgres", "pg_broken", "broken", false, "2024-03-01T00:00:00Z"),
+		connection("pg_old", "postgres", "pg_old", "connected", false, "2023-01-01T00:00:00Z"),
+		connection("sf_prod", "salesforce", "sf_prod", "connected", false, "2024-03-01T00:00:00Z"),
+	}
+
+	filter, diags := model.NewListFilter(listFilter(t, map[string]attr.Value{
+		"service":       types.StringValue("postgres"),
+		"paused":        types.BoolValue(false),
+		"setup_state":   types.StringValue("connected"),
+		"name_regex":    types.StringValue("^pg_"),
+		"created_after": types.StringValue("2024-01-01T00:00:00Z"),
+	}))
+	if diags.HasError() {
+		t.Fatalf("NewListFilter() diagnostics = %v", diags)
+	}
+
+	filtered := model.FilterList(filter, items, model.ConnectionFilterFields)
+	if len(filtered) != 1 || filtered[0].ID != "pg_prod" {
+		t.Errorf("FilterList() = %v, want only pg_prod", filtered)
+	}
+}
+
+func TestFilterListUnsetCriteria(t *testing.T) {
+	t.Parallel()
+
+	filter, diags := model.NewListFilter(listFilter(t, map[string]attr.Value{
+		"service":       types.StringNull(),
+		"paused":        types.BoolNull(),
+		"created_after": types.StringValue("2024-01-01T00:00:00Z"),
+	}))
+	if diags.HasError() {
+		t.Fatalf("NewListFilter() diagnostics = %v", diags)
+	}
+
+	items := []webhooks.WebhookCommonData{
+		{Id: "new", CreatedAt: "2024-06-01T10:00:00Z"},
+		{Id: "old", CreatedAt: "2023-06-01T10:00:00Z"},
+		{Id: "unknown", CreatedAt: ""},
+	}
+	filtered := model.FilterList(filter, items, model.WebhookFilterFields)
+	if len(filtered) != 1 || filtered[0].Id != "new" {
+		t.Errorf("FilterList() = %v, want only new", filtered)
+	}
+
+	if filtered := model.FilterList(filter, []webhooks.WebhookCommonData(nil), model.WebhookFilterFields); filtered != nil {
+		t.Errorf("FilterList(nil) = %v, want nil", filtered)
+	}
+	if filtered := model.FilterList(filter, []webhooks.WebhookCommonData{}, model.WebhookFilterFields); filtered == nil {
+		t.Errorf("FilterList([]) = nil, want empty list")
+	}
+
+	all, _ := model.NewListFilter(types.ObjectNull(map[string]attr.Type{"created_after": types.StringType}))
+	if filtered := model.FilterList(all, items, model.WebhookFilterFields); len(filtered) != len(items) {
+		t.Errorf("FilterList() with null filter = %v, want all items", filtered)
+	}
+}
+
+func TestNewListFilterInvalidCriteria(t *testing.T) {
+	t.Parallel()
+
+	_, diags := model.NewListFilter(listFilter(t, map[string]attr.Value{
+		"name_regex":    types.StringValue("pg_("),
+		"created_after": types.StringValue("2024-01-01"),
+	}))
+	if diags.ErrorsCount() != 2 {
+		t.Errorf("NewListFilter() diagnostics = %v, want errors for name_regex and created_after", diags)
+	}
+}
@@ -14,6 +14,12 @@ type Team struct {
     Role            types.String `tfsdk:"role"`
 }
 
+// TeamResourceModel extends the team model shared with the data source by the resource-only attributes.
+type TeamResourceModel struct {
+    Team
+    AdoptExisting types.Bool `tfsdk:"adopt_existing"`
+}
+
 func (d *Team) ReadFromResponse(ctx context.Context, resp teams.TeamsDetailsResponse) {
     d.Id = types.StringValue(resp.Data.Id)
     d.Name = types.StringValue(resp.Data.Name)
@@ -11,6 +11,7 @@ import (
 type Teams struct {
     Id       types.String `tfsdk:"id"` 
     Teams    types.Set    `tfsdk:"teams"`
+    Filter   types.Object `tfsdk:"filter"`
 }
 
 func (d *Teams) ReadFromResponse(ctx context.Context, resp teams.TeamsListResponse) {
@@ -23,6 +23,12 @@ type User struct {
 	CreatedAt  types.String `tfsdk:"created_at"`
 }
 
+// UserResourceModel extends the user model shared with the data source by the resource-only attributes.
+type UserResourceModel struct {
+	User
+	AdoptExisting types.Bool `tfsdk:"adopt_existing"`
+}
+
 func (d *User) ReadFromResponse(resp users.UserDetailsResponse) {
 	d.ID = types.StringValue(resp.Data.ID)
 	d.Email = types.StringValue(resp.Data.Email)
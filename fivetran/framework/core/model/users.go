@@ -11,6 +11,7 @@ import (
 type Users struct {
     Id       types.String `tfsdk:"id"` 
     Users    types.Set    `tfsdk:"users"`
+    Filter   types.Object `tfsdk:"filter"`
 }
 
 func (d *Users) ReadFromResponse(ctx context.Context, resp users.UsersListResponse) {
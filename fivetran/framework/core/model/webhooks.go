@@ -10,6 +10,7 @@ import (
 
 type Webhooks struct {
     Webhooks   types.Set `tfsdk:"webhooks"`
+    Filter     types.Object `tfsdk:"filter"`
 }
 
 func (d *Webhooks) ReadFromResponse(ctx context.Context, resp webhooks.WebhookListResponse) {
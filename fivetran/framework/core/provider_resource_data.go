@@ -4,12 +4,19 @@ import (
 	"sync"
 
 	fivetran "github.com/fivetran/go-fivetran"
+	configSchema "github.com/fivetran/terraform-provider-fivetran/modules/connector/schema"
 )
 
 // ProviderResourceData is passed as ResourceData to all resources.
-// It carries the Fivetran client and the per-provider-instance metadata cache.
+// It carries the Fivetran client, the per-provider-instance metadata and schema columns caches
+// and the registry of schema tables managed by resources. Data sources get it as DataSourceData as well.
 type ProviderResourceData struct {
 	Client                 *fivetran.Client
 	MetadataCache          *sync.Map
 	SkipPlanTimeValidation bool
+	FieldStatusPolicy      string
+	FailOnSetupTestFailure bool
+	ColumnFetcher          *configSchema.ColumnFetcher
+	FetchConcurrency       int
+	TableOwnership         *configSchema.TableOwnership
 }
@@ -61,6 +61,11 @@ func ConnectionAttributesSchema() core.Schema {
 				Description:  "Specifies whether we should trust the SSH fingerprint automatically. The default value is FALSE. If a fingerprint is not trusted automatically, it has to be approved with [Certificates Management API Approve a destination fingerprint](https://fivetran.com/docs/rest-api/certificates#approveadestinationfingerprint). **Note:** This is a plan-only attribute.",
 				ResourceOnly: true,
 			},
+			"adopt_existing": {
+				ValueType:    core.Boolean,
+				Description:  "Specifies whether an existing connection with the same destination schema in the group is adopted on creation instead of creating a new one. The adopted connection is updated to the configured values. The default value is FALSE.",
+				ResourceOnly: true,
+			},
 			"succeeded_at": {
 				DatasourceOnly: true,
 				ValueType:      core.String,
@@ -177,54 +182,72 @@ func connectionDestinationSchema() core.Schema {
 	}
 }
 
+func connectionsDatasourceItemAttributes() map[string]datasourceSchema.Attribute {
+	attributes := ConnectionAttributesSchema().GetDatasourceListSchema()
+	attributes["config"] = datasourceSchema.StringAttribute{
+		Computed:    true,
+		Description: "The JSON-encoded connection configuration, secrets are masked. Set only when `include_details` is `true`.",
+	}
+	attributes["status"] = datasourceSchema.SingleNestedAttribute{
+		Computed:    true,
+		Attributes:  connectionStatusAttributes(),
+		Description: "The connection status. Set only when `include_details` is `true`.",
+	}
+	return attributes
+}
+
 func connectionStatusBlock() datasourceSchema.SingleNestedBlock {
 	return datasourceSchema.SingleNestedBlock{
-		Attributes: map[string]datasourceSchema.Attribute{
-			"setup_state": datasourceSchema.StringAttribute{
-				Computed:    true,
-				Description: "The current setup state of the connection. The available values are: <br /> - incomplete - the setup config is incomplete, the setup tests never succeeded  `connected` - the connection is properly set up, `broken` - the connection setup config is broken.",
-			},
-			"is_historical_sync": datasourceSchema.BoolAttribute{
-				Computed:    true,
-				Description: "The boolean specifying whether the connection should be triggered to re-sync all historical data. If you set this parameter to TRUE, the next scheduled sync will be historical. If the value is FALSE or not specified, the connection will not re-sync historical data. NOTE: When the value is TRUE, only the next scheduled sync will be historical, all subsequent ones will be incremental. This parameter is set to FALSE once the historical sync is completed.",
-			},
-			"sync_state": datasourceSchema.StringAttribute{
-				Computed:    true,
-				Description: "The current sync state of the connection. The available values are: `scheduled` - the sync is waiting to be run, `syncing` - the sync is currently running, `paused` - the sync is currently paused, `rescheduled` - the sync is waiting until more API calls are available in the source service.",
-			},
-			"update_state": datasourceSchema.StringAttribute{
-				Computed:    true,
-				Description: "The current data update state of the connection. The available values are: `on_schedule` - the sync is running smoothly, no delays, `delayed` - the data is delayed for a longer time than expected for the update.",
-			},
-			"tasks": datasourceSchema.SetNestedAttribute{
-				Computed:    true,
-				Description: "The collection of tasks for the connection.",
-				NestedObject: datasourceSchema.NestedAttributeObject{
-					Attributes: map[string]datasourceSchema.Attribute{
-						"code": datasourceSchema.StringAttribute{
-							Computed:    true,
-							Description: "Task code.",
-						},
-						"message": datasourceSchema.StringAttribute{
-							Computed:    true,
-							Description: "Task message.",
-						},
+		Attributes: connectionStatusAttributes(),
+	}
+}
+
+func connectionStatusAttributes() map[string]datasourceSchema.Attribute {
+	return map[string]datasourceSchema.Attribute{
+		"setup_state": datasourceSchema.StringAttribute{
+			Computed:    true,
+			Description: "The current setup state of the connection. The available values are: <br /> - incomplete - the setup config is incomplete, the setup tests never succeeded  `connected` - the connection is properly set up, `broken` - the connection setup config is broken.",
+		},
+		"is_historical_sync": datasourceSchema.BoolAttribute{
+			Computed:    true,
+			Description: "The boolean specifying whether the connection should be triggered to re-sync all historical data. If you set this parameter to TRUE, the next scheduled sync will be historical. If the value is FALSE or not specified, the connection will not re-sync historical data. NOTE: When the value is TRUE, only the next scheduled sync will be historical, all subsequent ones will be incremental. This parameter is set to FALSE once the historical sync is completed.",
+		},
+		"sync_state": datasourceSchema.StringAttribute{
+			Computed:    true,
+			Description: "The current sync state of the connection. The available values are: `scheduled` - the sync is waiting to be run, `syncing` - the sync is currently running, `paused` - the sync is currently paused, `rescheduled` - the sync is waiting until more API calls are available in the source service.",
+		},
+		"update_state": datasourceSchema.StringAttribute{
+			Computed:    true,
+			Description: "The current data update state of the connection. The available values are: `on_schedule` - the sync is running smoothly, no delays, `delayed` - the data is delayed for a longer time than expected for the update.",
+		},
+		"tasks": datasourceSchema.SetNestedAttribute{
+			Computed:    true,
+			Description: "The collection of tasks for the connection.",
+			NestedObject: datasourceSchema.NestedAttributeObject{
+				Attributes: map[string]datasourceSchema.Attribute{
+					"code": datasourceSchema.StringAttribute{
+						Computed:    true,
+						Description: "Task code.",
+					},
+					"message": datasourceSchema.StringAttribute{
+						Computed:    true,
+						Description: "Task message.",
 					},
 				},
 			},
-			"warnings": datasourceSchema.SetNestedAttribute{
-				Computed:    true,
-				Description: "The collection of warnings for the connection.",
-				NestedObject: datasourceSchema.NestedAttributeObject{
-					Attributes: map[string]datasourceSchema.Attribute{
-						"code": datasourceSchema.StringAttribute{
-							Computed:    true,
-							Description: "Warning code.",
-						},
-						"message": datasourceSchema.StringAttribute{
-							Computed:    true,
-							Description: "Warning message.",
-						},
+		},
+		"warnings": datasourceSchema.SetNestedAttribute{
+			Computed:    true,
+			Description: "The collection of warnings for the connection.",
+			NestedObject: datasourceSchema.NestedAttributeObject{
+				Attributes: map[string]datasourceSchema.Attribute{
+					"code": datasourceSchema.StringAttribute{
+						Computed:    true,
+						Description: "Warning code.",
+					},
+					"message": datasourceSchema.StringAttribute{
+						Computed:    true,
+						Description: "Warning message.",
 					},
 				},
 			},
@@ -247,11 +270,16 @@ func ConnectionsDatasource() datasourceSchema.Schema {
 				Optional:    true,
 				Description: "The name used both as the connection's name within the Fivetran system and as the source schema's name within your destination.",
 			},
+			"include_details": datasourceSchema.BoolAttribute{
+				Optional:    true,
+				Description: "Read the details of every listed connection to set `config` and `status`. The details are read in parallel, with up to the provider `fetch_concurrency` requests at a time. Default: `false`.",
+			},
 		},
 		Blocks: map[string]datasourceSchema.Block{
+			"filter": listFilterBlock(ListFilterService, ListFilterPaused, ListFilterSetupState, ListFilterNameRegex, ListFilterCreatedAfter),
 			"connections": datasourceSchema.SetNestedBlock{
 				NestedObject: datasourceSchema.NestedBlockObject{
-					Attributes: ConnectionAttributesSchema().GetDatasourceListSchema(),
+					Attributes: connectionsDatasourceItemAttributes(),
 				},
 			},
 		},
@@ -0,0 +1,112 @@
+package schema
+
+import (
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func patchSettingsAttributes(element string, attributes map[string]datasourceSchema.Attribute) map[string]datasourceSchema.Attribute {
+	attributes["patch_allowed"] = datasourceSchema.BoolAttribute{
+		Computed:    true,
+		Description: "The boolean value specifying whether the `enabled` setting of the " + element + " can be changed.",
+	}
+	attributes["lock_reason_code"] = datasourceSchema.StringAttribute{
+		Computed:    true,
+		Description: "The reason code why the " + element + " can't be enabled or disabled, if it is locked.",
+	}
+	attributes["lock_reason"] = datasourceSchema.StringAttribute{
+		Computed:    true,
+		Description: "The human-readable reason why the " + element + " can't be enabled or disabled, if it is locked.",
+	}
+	return attributes
+}
+
+func connectionSchemaColumnsSchema(description string) datasourceSchema.MapNestedAttribute {
+	return datasourceSchema.MapNestedAttribute{
+		Computed:    true,
+		Description: description,
+		NestedObject: datasourceSchema.NestedAttributeObject{
+			Attributes: patchSettingsAttributes("column", map[string]datasourceSchema.Attribute{
+				"name_in_destination": datasourceSchema.StringAttribute{
+					Computed:    true,
+					Description: "The column name within your destination in accordance with Fivetran conventional rules.",
+				},
+				"enabled": datasourceSchema.BoolAttribute{
+					Computed:    true,
+					Description: "The boolean value specifying whether the sync of the column into the destination is enabled.",
+				},
+				"hashed": datasourceSchema.BoolAttribute{
+					Computed:    true,
+					Description: "The boolean value specifying whether the column is hashed.",
+				},
+				"is_primary_key": datasourceSchema.BoolAttribute{
+					Computed:    true,
+					Description: "The boolean value specifying whether the column is a primary key.",
+				},
+			}),
+		},
+	}
+}
+
+func connectionSchemaTablesSchema() datasourceSchema.MapNestedAttribute {
+	return datasourceSchema.MapNestedAttribute{
+		Computed:    true,
+		Description: "Map of schema tables keyed by table name.",
+		NestedObject: datasourceSchema.NestedAttributeObject{
+			Attributes: patchSettingsAttributes("table", map[string]datasourceSchema.Attribute{
+				"name_in_destination": datasourceSchema.StringAttribute{
+					Computed:    true,
+					Description: "The table name within your destination in accordance with Fivetran conventional rules.",
+				},
+				"enabled": datasourceSchema.BoolAttribute{
+					Computed:    true,
+					Description: "The boolean value specifying whether the sync for the table into the destination is enabled.",
+				},
+				"sync_mode": datasourceSchema.StringAttribute{
+					Computed:    true,
+					Description: "The table sync mode, if the connector supports switching sync modes for tables.",
+				},
+				"supports_columns_config": datasourceSchema.BoolAttribute{
+					Computed:    true,
+					Description: "The boolean value specifying whether the table supports columns configuration.",
+				},
+				"columns": connectionSchemaColumnsSchema("Map of table columns keyed by column name. Contains only columns returned by the schema config endpoint."),
+			}),
+		},
+	}
+}
+
+func ConnectionSchemaDatasource() datasourceSchema.Schema {
+	return datasourceSchema.Schema{
+		Attributes: map[string]datasourceSchema.Attribute{
+			"id": datasourceSchema.StringAttribute{
+				Required:    true,
+				Description: "The unique identifier for the connection within the Fivetran system.",
+			},
+			"schema_change_handling": datasourceSchema.StringAttribute{
+				Computed:    true,
+				Description: "The value specifying how new source data is handled.",
+			},
+			"schemas": datasourceSchema.MapNestedAttribute{
+				Computed:    true,
+				Description: "Map of connection schemas keyed by schema name.",
+				NestedObject: datasourceSchema.NestedAttributeObject{
+					Attributes: map[string]datasourceSchema.Attribute{
+						"name_in_destination": datasourceSchema.StringAttribute{
+							Computed:    true,
+							Description: "The schema name within your destination in accordance with Fivetran conventional rules.",
+						},
+						"enabled": datasourceSchema.BoolAttribute{
+							Computed:    true,
+							Description: "The boolean value specifying whether the sync for the schema into the destination is enabled.",
+						},
+						"tables": connectionSchemaTablesSchema(),
+					},
+				},
+			},
+			"schemas_json": datasourceSchema.StringAttribute{
+				Computed:    true,
+				Description: "The connection schemas in JSON format, as returned by the Fivetran API.",
+			},
+		},
+	}
+}
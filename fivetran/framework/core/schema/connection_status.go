@@ -0,0 +1,16 @@
+package schema
+
+import (
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func ConnectionStatusDatasource() datasourceSchema.Schema {
+	attributes := connectionStatusAttributes()
+	attributes["id"] = datasourceSchema.StringAttribute{
+		Required:    true,
+		Description: "The unique identifier for the connection within the Fivetran system.",
+	}
+	return datasourceSchema.Schema{
+		Attributes: attributes,
+	}
+}
@@ -0,0 +1,29 @@
+package schema
+
+import (
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func ConnectionTableColumnsDatasource() datasourceSchema.Schema {
+	return datasourceSchema.Schema{
+		Attributes: map[string]datasourceSchema.Attribute{
+			"id": datasourceSchema.StringAttribute{
+				Computed:    true,
+				Description: "The table identifier in `connection_id/schema/table` format.",
+			},
+			"connection_id": datasourceSchema.StringAttribute{
+				Required:    true,
+				Description: "The unique identifier for the connection within the Fivetran system.",
+			},
+			"schema": datasourceSchema.StringAttribute{
+				Required:    true,
+				Description: "The schema name as it is listed in the connection schema config.",
+			},
+			"table": datasourceSchema.StringAttribute{
+				Required:    true,
+				Description: "The table name as it is listed in the connection schema config.",
+			},
+			"columns": connectionSchemaColumnsSchema("Map of all source table columns keyed by column name."),
+		},
+	}
+}
@@ -1,6 +1,9 @@
 package schema
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -11,7 +14,12 @@ import (
 func ConnectionV2ResourceSchema() resourceSchema.Schema {
 	return resourceSchema.Schema{
 		Attributes: ConnectionV2ResourceAttributes(),
-		Version:    0,
+		Blocks: map[string]resourceSchema.Block{
+			"timeouts": timeouts.Block(context.Background(), timeouts.Opts{
+				Create: true,
+			}),
+		},
+		Version: 0,
 	}
 }
 
@@ -164,7 +172,16 @@ func ConnectionV2ResourceAttributes() map[string]resourceSchema.Attribute {
 			Optional:    true,
 			Description: "Specifies whether Fivetran should trust SSH fingerprints automatically. This is a plan-only attribute.",
 		},
-		"status": connectionV2StatusAttribute(),
+		"fail_on_setup_test_failure": resourceSchema.BoolAttribute{
+			Optional:    true,
+			Description: "Whether setup tests that are neither PASSED nor SKIPPED fail the apply instead of producing warnings. Defaults to the provider `fail_on_setup_test_failure` setting. This is a plan-only attribute.",
+		},
+		"rollback_on_failure": resourceSchema.BoolAttribute{
+			Optional:    true,
+			Description: "Whether the connection is deleted if its creation fails on setup tests or `wait_for` conditions, so a retry starts clean. Otherwise the created connection is kept in state and marked as tainted. This is a plan-only attribute.",
+		},
+		"status":   connectionV2StatusAttribute(),
+		"wait_for": connectionWaitForAttribute(),
 	}
 
 	return attributes
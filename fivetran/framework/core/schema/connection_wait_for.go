@@ -0,0 +1,41 @@
+package schema
+
+import (
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+const connectionWaitForDescription = "Conditions to wait for after the connection is created. The connection details are polled until the conditions are met, " +
+	"within the `create` timeout (30 minutes by default). If the conditions are not met, the apply fails with the failed setup tests and connection tasks, " +
+	"and the created connection is marked as tainted. The settings have no effect on existing connections."
+
+func connectionWaitForAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"setup_state_connected": resourceSchema.BoolAttribute{
+			Optional:    true,
+			Description: "Wait until the connection setup state is `connected`. The setup state changes once the setup tests pass, so set `run_setup_tests = true` as well.",
+		},
+		"initial_sync_complete": resourceSchema.BoolAttribute{
+			Optional:    true,
+			Description: "Wait until the first sync of the connection succeeds. The connection is unpaused to run the sync.",
+		},
+		"unpause": resourceSchema.BoolAttribute{
+			Optional:    true,
+			Description: "Unpause the connection, connections are always created paused. If `setup_state_connected` is set, the connection is unpaused once it is connected.",
+		},
+	}
+}
+
+func connectionWaitForBlock() resourceSchema.SingleNestedBlock {
+	return resourceSchema.SingleNestedBlock{
+		Description: connectionWaitForDescription,
+		Attributes:  connectionWaitForAttributes(),
+	}
+}
+
+func connectionWaitForAttribute() resourceSchema.SingleNestedAttribute {
+	return resourceSchema.SingleNestedAttribute{
+		Optional:    true,
+		Description: connectionWaitForDescription,
+		Attributes:  connectionWaitForAttributes(),
+	}
+}
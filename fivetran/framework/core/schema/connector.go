@@ -69,6 +69,21 @@ func ConnectorAttributesSchema() core.Schema {
 				Description:  "Specifies whether we should trust the SSH fingerprint automatically. The default value is FALSE. If a fingerprint is not trusted automatically, it has to be approved with [Certificates Management API Approve a destination fingerprint](https://fivetran.com/docs/rest-api/certificates#approveadestinationfingerprint).",
 				ResourceOnly: true,
 			},
+			"fail_on_setup_test_failure": {
+				ValueType:    core.Boolean,
+				Description:  "Specifies whether setup tests that are neither PASSED nor SKIPPED fail the apply instead of producing warnings. Defaults to the provider `fail_on_setup_test_failure` setting.",
+				ResourceOnly: true,
+			},
+			"rollback_on_failure": {
+				ValueType:    core.Boolean,
+				Description:  "Specifies whether the connector is deleted if its creation fails on setup tests or `wait_for` conditions, so a retry starts clean. Otherwise the created connector is kept in state and marked as tainted. The default value is FALSE.",
+				ResourceOnly: true,
+			},
+			"adopt_existing": {
+				ValueType:    core.Boolean,
+				Description:  "Specifies whether an existing connector with the same destination schema in the group is adopted on creation instead of creating a new one. The adopted connector is updated to the configured values, `wait_for` and `rollback_on_failure` don't apply to it. The default value is FALSE.",
+				ResourceOnly: true,
+			},
 			"succeeded_at": {
 				DatasourceOnly: true,
 				ValueType:      core.String,
@@ -154,6 +169,7 @@ func ConnectorResourceBlocks(ctx context.Context) map[string]resourceSchema.Bloc
 			Create: true,
 			Update: true,
 		}),
+		"wait_for": connectionWaitForBlock(),
 	}
 }
 
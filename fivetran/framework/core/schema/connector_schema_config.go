@@ -5,6 +5,7 @@ import (
 
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/fivetrantypes"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -51,7 +52,7 @@ func GetConnectorSchemaResourceSchema(ctx context.Context) schema.Schema {
 The value defines validation method. 
 - NONE: no validation, any configuration accepted. 
 - TABLES: validate table names, fail on attempt to configure non-existing schemas/tables.
-- COLUMNS: validate the whole schema config including column names. The resource will try to fetch columns for every configured table and verify column names.
+- COLUMNS: validate the whole schema config including column names. The resource will try to fetch columns for every configured table and verify column names. Columns are fetched in parallel (see provider `+"`fetch_concurrency`"+`) and reused across validations within one apply.
 `,
 			},
 			"schemas": schema.MapNestedAttribute{
@@ -118,9 +119,53 @@ The value defines validation method.
 				CustomType:  fivetrantypes.JsonSchemaType{},
 				Description: "Schema settings in Json format, following Fivetran API endpoint contract for `schemas` field (a map of schemas).",
 			},
+			"on_destroy": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("retain", "block_all", "reset_to_allow_all"),
+				},
+				Description: `
+The action performed on the connection schema config when the resource is destroyed. Default value: ` + "`retain`" + `.
+- retain: the schema config is left as is.
+- block_all: ` + "`schema_change_handling`" + ` is set to ` + "`BLOCK_ALL`" + ` and all schemas, tables and columns that are not locked are disabled.
+- reset_to_allow_all: ` + "`schema_change_handling`" + ` is set to ` + "`ALLOW_ALL`" + `, all schemas, tables and columns that are not locked are enabled and column hashing is reset.
+`,
+			},
+			"max_tables_per_request": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Description: "The maximum number of updated tables sent in a single schema config update request. Large patches are split into several requests, failed requests are reported separately. By default the whole patch is sent in one request.",
+			},
+			"resync_on_change": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Resync tables whose `sync_mode` or column `hashed` settings are changed by the applied schema config patch. Resynced tables are listed in a warning. Default value: `false`.",
+			},
+			"resolved_rules": schema.MapNestedAttribute{
+				Computed:    true,
+				Description: "Effect of `rule` blocks resolved against the upstream schema config. Keys are element paths in `schema`, `schema.table` or `schema.table.column` form.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"enabled": schema.BoolAttribute{
+							Computed:    true,
+							Description: "The resolved `enabled` value.",
+						},
+						"hashed": schema.BoolAttribute{
+							Computed:    true,
+							Description: "The resolved `hashed` value.",
+						},
+						"sync_mode": schema.StringAttribute{
+							Computed:    true,
+							Description: "The resolved `sync_mode` value.",
+						},
+					},
+				},
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"schema": getSchemaBlock(),
+			"rule":   getRuleBlock(),
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
 				Read:   true,
 				Create: true,
@@ -210,3 +255,49 @@ func getColumnBlock() schema.SetNestedBlock {
 		},
 	}
 }
+
+func getRuleBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: "Ordered pattern rules applied to schemas, tables and columns that are not configured explicitly. Later rules take precedence over earlier ones.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"match_type": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     stringdefault.StaticString("REGEX"),
+					Description: "The pattern syntax: `REGEX` (default, [RE2 syntax](https://github.com/google/re2/wiki/Syntax)) or `GLOB`. Patterns of both syntaxes have to match the whole name, e.g. `.*_archive` matches `orders_archive` but not `orders_archive_v2`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("REGEX", "GLOB"),
+					},
+				},
+				"schema": schema.StringAttribute{
+					Optional:    true,
+					Description: "The schema name pattern, matching the whole schema name. Matches any schema if not set.",
+				},
+				"table": schema.StringAttribute{
+					Optional:    true,
+					Description: "The table name pattern, matching the whole table name. If set, the rule applies to tables.",
+				},
+				"column": schema.StringAttribute{
+					Optional:    true,
+					Description: "The column name pattern, matching the whole column name. If set, the rule applies to columns.",
+				},
+				"enabled": schema.BoolAttribute{
+					Optional:    true,
+					Description: "The `enabled` value for matching elements.",
+				},
+				"hashed": schema.BoolAttribute{
+					Optional:    true,
+					Description: "The `hashed` value for matching columns.",
+				},
+				"sync_mode": schema.StringAttribute{
+					Optional:    true,
+					Description: "The `sync_mode` value for matching tables.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("HISTORY", "SOFT_DELETE", "LIVE"),
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,88 @@
+package schema
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func GetConnectorSchemaTableResourceSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique resource identifier in `connection_id/schema/table` format.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connection_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The unique identifier for the connection within the Fivetran system.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schema": schema.StringAttribute{
+				Required:    true,
+				Description: "The schema name within your destination in accordance with Fivetran conventional rules. The name can't contain `/`, the separator of the resource `id` parts.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[^/]+$`), "must not contain `/`, the resource id is in `connection_id/schema/table` format"),
+				},
+			},
+			"table": schema.StringAttribute{
+				Required:    true,
+				Description: "The table name within your destination in accordance with Fivetran conventional rules.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The boolean value specifying whether the sync for the table into the destination is enabled.",
+			},
+			"sync_mode": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "This field appears in the response if the connector supports switching sync modes for tables.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("HISTORY", "SOFT_DELETE", "LIVE"),
+				},
+			},
+			"columns": schema.MapNestedAttribute{
+				Optional:    true,
+				Description: "Map of column configurations. Columns that are not listed keep their current settings.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"enabled": schema.BoolAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "The boolean value specifying whether the sync of the column into the destination is enabled.",
+						},
+						"hashed": schema.BoolAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "The boolean value specifying whether a column should be hashed.",
+						},
+						"is_primary_key": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Boolean value indicating if the column is a primary key. This field is read-only and computed by the API.",
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
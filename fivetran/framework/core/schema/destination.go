@@ -55,6 +55,16 @@ func DestinationAttributesSchema() core.Schema {
 				Description:  "Specifies whether the setup tests should be run automatically. The default value is TRUE.",
 				ResourceOnly: true,
 			},
+			"fail_on_setup_test_failure": {
+				ValueType:    core.Boolean,
+				Description:  "Specifies whether setup tests that are neither PASSED nor SKIPPED fail the apply instead of producing warnings. Defaults to the provider `fail_on_setup_test_failure` setting.",
+				ResourceOnly: true,
+			},
+			"rollback_on_failure": {
+				ValueType:    core.Boolean,
+				Description:  "Specifies whether the destination is deleted if its creation fails on setup tests, so a retry starts clean. Otherwise the created destination is kept in state and marked as tainted. The default value is FALSE.",
+				ResourceOnly: true,
+			},
 			"setup_status": {
 				Readonly:    true,
 				ValueType:   core.String,
@@ -121,6 +131,7 @@ func DestinationsDatasource() datasourceSchema.Schema {
 			},
 		},
 		Blocks: map[string]datasourceSchema.Block{
+			"filter": listFilterBlock(ListFilterService, ListFilterSetupState),
 			"destinations": datasourceSchema.SetNestedBlock{
 				NestedObject: datasourceSchema.NestedBlockObject{
 					Attributes: DestinationAttributesSchema().GetDatasourceListSchema(),
@@ -0,0 +1,122 @@
+package schema
+
+import (
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+func DestinationV2ResourceSchema() resourceSchema.Schema {
+	return resourceSchema.Schema{
+		Attributes: DestinationV2ResourceAttributes(),
+		Version:    0,
+	}
+}
+
+func DestinationV2ResourceAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"id": resourceSchema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier for the destination within the Fivetran system.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"group_id": resourceSchema.StringAttribute{
+			Required:    true,
+			Description: "The unique identifier for the Group within the Fivetran system.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"service": resourceSchema.StringAttribute{
+			Required:    true,
+			Description: "The destination type id within the Fivetran system.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"region": resourceSchema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Data processing location. This is where Fivetran will operate and run computation on data.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"time_zone_offset": resourceSchema.StringAttribute{
+			Required:    true,
+			Description: "Determines the time zone for the Fivetran sync schedule.",
+		},
+		"setup_status": resourceSchema.StringAttribute{
+			Computed:    true,
+			Description: "Destination setup status.",
+		},
+		"daylight_saving_time_enabled": resourceSchema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Shift my UTC offset with daylight savings time (US Only)",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"config": resourceSchema.DynamicAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Service-specific destination configuration. Sensitive fields keep the configured value because the API does not return secrets.",
+		},
+		"hybrid_deployment_agent_id": resourceSchema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The hybrid deployment agent ID that refers to the controller created for the group the connection belongs to. If the value is specified, the system will try to associate the connection with an existing agent.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"networking_method": resourceSchema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Possible values: Directly, SshTunnel, ProxyAgent, PrivateLink.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"private_link_id": resourceSchema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The private link ID.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"proxy_agent_id": resourceSchema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The proxy agent ID.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"run_setup_tests": resourceSchema.BoolAttribute{
+			Optional:    true,
+			Description: "Specifies whether the setup tests should be run automatically. This is a plan-only attribute.",
+		},
+		"trust_certificates": resourceSchema.BoolAttribute{
+			Optional:    true,
+			Description: "Specifies whether we should trust the certificate automatically. This is a plan-only attribute.",
+		},
+		"trust_fingerprints": resourceSchema.BoolAttribute{
+			Optional:    true,
+			Description: "Specifies whether we should trust the SSH fingerprint automatically. This is a plan-only attribute.",
+		},
+		"fail_on_setup_test_failure": resourceSchema.BoolAttribute{
+			Optional:    true,
+			Description: "Whether setup tests that are neither PASSED nor SKIPPED fail the apply instead of producing warnings. Defaults to the provider `fail_on_setup_test_failure` setting. This is a plan-only attribute.",
+		},
+		"rollback_on_failure": resourceSchema.BoolAttribute{
+			Optional:    true,
+			Description: "Whether the destination is deleted if its creation fails on setup tests, so a retry starts clean. Otherwise the created destination is kept in state and marked as tainted. This is a plan-only attribute.",
+		},
+	}
+}
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+func ExternalLoggingV2ResourceSchema() resourceSchema.Schema {
+	return resourceSchema.Schema{
+		Attributes: ExternalLoggingV2ResourceAttributes(),
+		Version:    0,
+	}
+}
+
+func ExternalLoggingV2ResourceAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"id": resourceSchema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier for the log service within the Fivetran system.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"group_id": resourceSchema.StringAttribute{
+			Required:    true,
+			Description: "The unique identifier for the Group within the Fivetran system.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"service": resourceSchema.StringAttribute{
+			Required:    true,
+			Description: "The name for the log service type within the Fivetran system, for example: azure_monitor_log, cloudwatch, datadog_log, new_relic_log, splunkLog, stackdriver.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"enabled": resourceSchema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The boolean value specifying whether the log service is enabled. The default value is TRUE.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"config": resourceSchema.DynamicAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Service-specific log service configuration. Sensitive fields keep the configured value because the API does not return secrets.",
+		},
+		"run_setup_tests": resourceSchema.BoolAttribute{
+			Optional:    true,
+			Description: "Specifies whether the setup tests should be run automatically. This is a plan-only attribute.",
+		},
+	}
+}
@@ -30,6 +30,11 @@ func GroupSchema() core.Schema {
 				ValueType:   core.String,
 				Description: "The timestamp of when the resource/datasource was updated last time.",
 			},
+			"adopt_existing": {
+				ValueType:    core.Boolean,
+				Description:  "Specifies whether an existing group with the same `name` is adopted on creation instead of creating a new one. The default value is FALSE.",
+				ResourceOnly: true,
+			},
 		},
 	}
 }
@@ -55,6 +60,7 @@ func GroupsDatasource() datasourceSchema.Schema {
 			},
 		},
 		Blocks: map[string]datasourceSchema.Block{
+			"filter": listFilterBlock(ListFilterNameRegex, ListFilterCreatedAfter),
 			"groups": datasourceSchema.SetNestedBlock{
 				NestedObject: datasourceSchema.NestedBlockObject{
 					Attributes: GroupSchema().GetDatasourceSchema(),
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+)
+
+// IdIdentitySchema is the identity schema of resources identified by their id alone.
+func IdIdentitySchema(description string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       description,
+			},
+		},
+	}
+}
+
+// ConnectionIdentitySchema is the identity schema of connections, connections are imported either by `id`
+// or by `group_id` and `schema_name`.
+func ConnectionIdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "The unique identifier for the connection within the Fivetran system.",
+			},
+			"group_id": identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "The unique identifier for the group (destination) the connection belongs to. Used together with `schema_name` when `id` is not set.",
+			},
+			"schema_name": identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "The name of the destination schema the connection writes to. Used together with `group_id` when `id` is not set.",
+			},
+		},
+	}
+}
+
+// TeamMembershipIdentitySchema is the identity schema of team memberships, the resources manage all memberships of one team
+// and are identified by the `team_id`.
+func TeamMembershipIdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"team_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The unique identifier for the team within your account. The resource manages all memberships of the team.",
+			},
+		},
+	}
+}
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// Criteria of the `filter` block of the plural data sources.
+const (
+	ListFilterService      = "service"
+	ListFilterPaused       = "paused"
+	ListFilterSetupState   = "setup_state"
+	ListFilterNameRegex    = "name_regex"
+	ListFilterEmailRegex   = "email_regex"
+	ListFilterRole         = "role"
+	ListFilterCreatedAfter = "created_after"
+)
+
+var listFilterAttributes = map[string]datasourceSchema.Attribute{
+	ListFilterService: datasourceSchema.StringAttribute{
+		Optional:    true,
+		Description: "Return only items of the given service type.",
+	},
+	ListFilterPaused: datasourceSchema.BoolAttribute{
+		Optional:    true,
+		Description: "Return only paused (`true`) or only active (`false`) items.",
+	},
+	ListFilterSetupState: datasourceSchema.StringAttribute{
+		Optional:    true,
+		Description: "Return only items in the given setup state, e.g. `connected`, `incomplete` or `broken`.",
+	},
+	ListFilterNameRegex: datasourceSchema.StringAttribute{
+		Optional:    true,
+		Description: "Return only items whose name matches the regular expression ([RE2 syntax](https://github.com/google/re2/wiki/Syntax)). The expression is not anchored, use `^` and `$` to match the whole name.",
+	},
+	ListFilterEmailRegex: datasourceSchema.StringAttribute{
+		Optional:    true,
+		Description: "Return only items whose email matches the regular expression ([RE2 syntax](https://github.com/google/re2/wiki/Syntax)). The expression is not anchored, use `^` and `$` to match the whole email.",
+	},
+	ListFilterRole: datasourceSchema.StringAttribute{
+		Optional:    true,
+		Description: "Return only items with the given role, e.g. `Account Administrator`.",
+	},
+	ListFilterCreatedAfter: datasourceSchema.StringAttribute{
+		Optional:    true,
+		Description: "Return only items created after the given [RFC 3339](https://www.rfc-editor.org/rfc/rfc3339) timestamp, e.g. `2024-01-01T00:00:00Z`.",
+	},
+}
+
+// listFilterBlock returns the `filter` block with the given criteria. The list endpoints don't support any of them, so all
+// criteria are applied in memory to the listed items.
+func listFilterBlock(criteria ...string) datasourceSchema.Block {
+	attributes := make(map[string]datasourceSchema.Attribute, len(criteria))
+	for _, name := range criteria {
+		attributes[name] = listFilterAttributes[name]
+	}
+	return datasourceSchema.SingleNestedBlock{
+		Attributes:  attributes,
+		Description: "Filters the returned items, all set criteria have to match.",
+	}
+}
@@ -24,6 +24,11 @@ func TeamResource() resourceSchema.Schema {
 				Required:    true,
 				Description: "The account role of the team.",
 			},
+			"adopt_existing": resourceSchema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies whether an existing team with the same `name` is adopted on creation instead of creating a new one. The adopted team is updated to the configured values. The default value is FALSE.",
+			},
 		},
 	}
 }
@@ -60,6 +65,7 @@ func TeamsDatasource() datasourceSchema.Schema {
             },
         },
 		Blocks: map[string]datasourceSchema.Block{
+			"filter": listFilterBlock(ListFilterNameRegex, ListFilterRole),
 			"teams": datasourceSchema.SetNestedBlock{
 				NestedObject: datasourceSchema.NestedBlockObject{
 					Attributes: map[string]datasourceSchema.Attribute{
@@ -59,6 +59,11 @@ func User() core.Schema {
 				ValueType:   core.String,
 				Description: "The timestamp that the user created their Fivetran account.",
 			},
+			"adopt_existing": {
+				ValueType:    core.Boolean,
+				Description:  "Specifies whether an existing user with the same `email` is adopted on creation instead of inviting a new one. The adopted user is updated to the configured values. The default value is FALSE.",
+				ResourceOnly: true,
+			},
 		},
 	}
 }
@@ -72,6 +77,7 @@ func UsersDatasource() datasourceSchema.Schema {
             },
         },
 		Blocks: map[string]datasourceSchema.Block{
+			"filter": listFilterBlock(ListFilterEmailRegex, ListFilterRole, ListFilterCreatedAfter),
 			"users": datasourceSchema.SetNestedBlock{
 				NestedObject: datasourceSchema.NestedBlockObject{
 					Attributes: map[string]datasourceSchema.Attribute{
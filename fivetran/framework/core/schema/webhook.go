@@ -121,5 +121,8 @@ func WebhooksDatasource() datasourceSchema.Schema {
 				},
 			},
 		},
+		Blocks: map[string]datasourceSchema.Block{
+			"filter": listFilterBlock(ListFilterCreatedAfter),
+		},
 	}
 }
\ No newline at end of file
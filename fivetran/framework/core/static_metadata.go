@@ -0,0 +1,62 @@
+package core
+
+import (
+	"github.com/fivetran/go-fivetran/metadata"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/common"
+)
+
+// StaticMetadataSlot builds a metadata slot for a service from the field definitions bundled with
+// the provider. The API exposes metadata only for connector services, destinations and external
+// logging services use this instead. Field status is not known for static fields.
+func StaticMetadataSlot(fields map[string]common.ConfigField, service string) *metadata.Property {
+	slot := &metadata.Property{
+		Type:       "object",
+		Properties: make(map[string]*metadata.Property, len(fields)),
+	}
+	for name, field := range fields {
+		key := name
+		if field.ApiField != "" {
+			key = field.ApiField
+		}
+		slot.Properties[key] = staticMetadataProperty(field, service)
+	}
+	return slot
+}
+
+func staticMetadataProperty(field common.ConfigField, service string) *metadata.Property {
+	prop := &metadata.Property{
+		Readonly: field.Readonly,
+		Nullable: field.Nullable,
+	}
+	if field.GetIsSensitive(service) {
+		prop.Format = "password"
+	}
+
+	switch field.FieldValueType {
+	case common.String:
+		prop.Type = "string"
+		switch field.ItemType[service] {
+		case common.Integer:
+			prop.Type = "integer"
+		case common.Float:
+			prop.Type = "number"
+		}
+	case common.Integer:
+		prop.Type = "integer"
+	case common.Float:
+		prop.Type = "number"
+	case common.Boolean:
+		prop.Type = "boolean"
+	case common.StringList:
+		prop.Type = "array"
+		prop.Items = &metadata.Property{Type: "string"}
+	case common.ObjectList:
+		prop.Type = "array"
+		prop.Items = StaticMetadataSlot(field.ItemFields, service)
+	case common.Object:
+		nested := StaticMetadataSlot(field.ItemFields, service)
+		prop.Type = "object"
+		prop.Properties = nested.Properties
+	}
+	return prop
+}
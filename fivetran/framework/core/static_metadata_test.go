@@ -0,0 +1,69 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/common"
+)
+
+func TestStaticMetadataSlot(t *testing.T) {
+	fields := map[string]common.ConfigField{
+		"host":        {FieldValueType: common.String},
+		"port":        {FieldValueType: common.Integer},
+		"timeout":     {FieldValueType: common.String, ItemType: map[string]common.FieldValueType{"snowflake": common.Integer}},
+		"password":    {FieldValueType: common.String, Sensitive: true, Nullable: true},
+		"public_key":  {FieldValueType: common.String, Readonly: true},
+		"servers":     {FieldValueType: common.StringList},
+		"is_private":  {FieldValueType: common.Boolean},
+		"custom_name": {FieldValueType: common.String, ApiField: "name"},
+		"reports": {FieldValueType: common.ObjectList, ItemFields: map[string]common.ConfigField{
+			"table": {FieldValueType: common.String},
+		}},
+		"tunnel": {FieldValueType: common.Object, ItemFields: map[string]common.ConfigField{
+			"key": {FieldValueType: common.String, Sensitive: true},
+		}},
+	}
+
+	slot := StaticMetadataSlot(fields, "snowflake")
+
+	wantTypes := map[string]string{
+		"host":       "string",
+		"port":       "integer",
+		"timeout":    "integer",
+		"password":   "string",
+		"public_key": "string",
+		"servers":    "array",
+		"is_private": "boolean",
+		"name":       "string",
+		"reports":    "array",
+		"tunnel":     "object",
+	}
+	for name, want := range wantTypes {
+		prop := SlotProp(slot, name)
+		if prop == nil {
+			t.Fatalf("%s is missing from the slot", name)
+		}
+		if prop.Type != want {
+			t.Fatalf("%s type = %q, want %q", name, prop.Type, want)
+		}
+	}
+
+	if SlotProp(slot, "custom_name") != nil {
+		t.Fatal("fields with api_field must be keyed by the API name")
+	}
+	if p := SlotProp(slot, "password"); p.Format != "password" || !p.Nullable {
+		t.Fatalf("password = %+v, want sensitive nullable field", p)
+	}
+	if !SlotProp(slot, "public_key").Readonly {
+		t.Fatal("public_key should be readonly")
+	}
+	if SlotProp(slot, "servers").Items.Type != "string" {
+		t.Fatal("servers items should be strings")
+	}
+	if SlotProp(SlotProp(slot, "reports").Items, "table") == nil {
+		t.Fatal("reports items should describe table")
+	}
+	if SlotProp(SlotProp(slot, "tunnel"), "key").Format != "password" {
+		t.Fatal("tunnel.key should be sensitive")
+	}
+}
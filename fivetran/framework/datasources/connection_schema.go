@@ -0,0 +1,68 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func ConnectionSchema() datasource.DataSource {
+	return &connectionSchema{}
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ datasource.DataSourceWithConfigure = &connectionSchema{}
+
+type connectionSchema struct {
+	core.ProviderDatasource
+}
+
+func (d *connectionSchema) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "fivetran_connection_schema"
+}
+
+func (d *connectionSchema) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = fivetranSchema.ConnectionSchemaDatasource()
+}
+
+func (d *connectionSchema) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.ConnectionSchema
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := d.GetClient().NewConnectionSchemaDetails().ConnectionID(data.Id.ValueString()).Do(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Connection Schema Read Error.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, response.Code, response.Message),
+		)
+		return
+	}
+
+	if err := data.ReadFromResponse(response); err != nil {
+		resp.Diagnostics.AddError(
+			"Connection Schema Read Error.",
+			fmt.Sprintf("Unable to encode schemas_json: %v", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
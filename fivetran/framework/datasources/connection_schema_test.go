@@ -0,0 +1,122 @@
+package datasources_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/fivetran/go-fivetran/tests/mock"
+	tfmock "github.com/fivetran/terraform-provider-fivetran/fivetran/tests/mock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestDataSourceConnectionSchemaMappingMock(t *testing.T) {
+	var schemaGetHandler *mock.Handler
+
+	step1 := resource.TestStep{
+		Config: `
+		data "fivetran_connection_schema" "test_data" {
+			provider = fivetran-provider
+			id = "connection_id"
+		}`,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				tfmock.AssertEqual(t, schemaGetHandler.Interactions, 1)
+				return nil
+			},
+			resource.TestCheckResourceAttr("data.fivetran_connection_schema.test_data", "id", "connection_id"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_schema.test_data", "schema_change_handling", "ALLOW_COLUMNS"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_schema.test_data", "schemas.schema_1.enabled", "true"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_schema.test_data", "schemas.schema_1.name_in_destination", "schema_1"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_schema.test_data", "schemas.schema_1.tables.table_1.sync_mode", "HISTORY"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_schema.test_data", "schemas.schema_1.tables.table_1.patch_allowed", "true"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_schema.test_data", "schemas.schema_1.tables.table_1.supports_columns_config", "true"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_schema.test_data", "schemas.schema_1.tables.table_1.columns.column_1.is_primary_key", "true"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_schema.test_data", "schemas.schema_1.tables.table_1.columns.column_1.patch_allowed", "false"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_schema.test_data", "schemas.schema_1.tables.table_1.columns.column_1.lock_reason_code", "SYSTEM_COLUMN"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_schema.test_data", "schemas.schema_1.tables.table_1.columns.column_2.hashed", "true"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_schema.test_data", "schemas.schema_1.tables.table_2.enabled", "true"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_schema.test_data", "schemas.schema_1.tables.table_2.patch_allowed", "false"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_schema.test_data", "schemas.schema_1.tables.table_2.lock_reason_code", "SYSTEM_TABLE"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_schema.test_data", "schemas.schema_1.tables.table_2.lock_reason", "The table does not support exclusion"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_schema.test_data", "schemas.schema_1.tables.table_2.columns.%", "0"),
+			resource.TestCheckResourceAttrSet("data.fivetran_connection_schema.test_data", "schemas_json"),
+		),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				tfmock.MockClient().Reset()
+
+				schemaGetHandler = tfmock.MockClient().When(http.MethodGet, "/v1/connections/connection_id/schemas").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						var responseData = tfmock.CreateMapFromJsonString(t, `
+						{
+							"schema_change_handling": "ALLOW_COLUMNS",
+							"schemas": {
+								"schema_1": {
+									"name_in_destination": "schema_1",
+									"enabled": true,
+									"tables": {
+										"table_1": {
+											"name_in_destination": "table_1",
+											"enabled": true,
+											"sync_mode": "HISTORY",
+											"supports_columns_config": true,
+											"enabled_patch_settings": {
+												"allowed": true
+											},
+											"columns": {
+												"column_1": {
+													"name_in_destination": "column_1",
+													"enabled": true,
+													"hashed": false,
+													"is_primary_key": true,
+													"enabled_patch_settings": {
+														"allowed": false,
+														"reason_code": "SYSTEM_COLUMN",
+														"reason": "The column does not support exclusion as it is a Primary Key"
+													}
+												},
+												"column_2": {
+													"name_in_destination": "column_2",
+													"enabled": true,
+													"hashed": true,
+													"enabled_patch_settings": {
+														"allowed": true
+													}
+												}
+											}
+										},
+										"table_2": {
+											"name_in_destination": "table_2",
+											"enabled": true,
+											"sync_mode": "SOFT_DELETE",
+											"enabled_patch_settings": {
+												"allowed": false,
+												"reason_code": "SYSTEM_TABLE",
+												"reason": "The table does not support exclusion"
+											}
+										}
+									}
+								}
+							}
+						}
+						`)
+						return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Success", responseData), nil
+					},
+				)
+			},
+			ProtoV6ProviderFactories: tfmock.ProtoV6ProviderFactories,
+			CheckDestroy: func(s *terraform.State) error {
+				return nil
+			},
+			Steps: []resource.TestStep{
+				step1,
+			},
+		},
+	)
+}
@@ -0,0 +1,62 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func ConnectionStatus() datasource.DataSource {
+	return &connectionStatus{}
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ datasource.DataSourceWithConfigure = &connectionStatus{}
+
+type connectionStatus struct {
+	core.ProviderDatasource
+}
+
+func (d *connectionStatus) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "fivetran_connection_status"
+}
+
+func (d *connectionStatus) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = fivetranSchema.ConnectionStatusDatasource()
+}
+
+func (d *connectionStatus) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.ConnectionStatus
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := d.GetClient().NewConnectionDetails().ConnectionID(data.Id.ValueString()).DoCustom(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Read error.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, response.Code, response.Message),
+		)
+		return
+	}
+
+	data.ReadFromResponse(response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,84 @@
+package datasources_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/fivetran/go-fivetran/tests/mock"
+	tfmock "github.com/fivetran/terraform-provider-fivetran/fivetran/tests/mock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestDataSourceConnectionStatusMappingMock(t *testing.T) {
+	var statusGetHandler *mock.Handler
+
+	step1 := resource.TestStep{
+		Config: `
+		data "fivetran_connection_status" "test" {
+			provider = fivetran-provider
+			id = "connection_id"
+		}`,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				tfmock.AssertEqual(t, statusGetHandler.Interactions, 1)
+				return nil
+			},
+			resource.TestCheckResourceAttr("data.fivetran_connection_status.test", "id", "connection_id"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_status.test", "setup_state", "broken"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_status.test", "sync_state", "paused"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_status.test", "update_state", "delayed"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_status.test", "is_historical_sync", "false"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_status.test", "tasks.#", "1"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_status.test", "tasks.0.code", "reconnect"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_status.test", "tasks.0.message", "Reconnect"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_status.test", "warnings.#", "1"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_status.test", "warnings.0.code", "snowflake_discontinuing_password_auth"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_status.test", "warnings.0.message", "Password authentication is deprecated"),
+		),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				tfmock.MockClient().Reset()
+
+				statusGetHandler = tfmock.MockClient().When(http.MethodGet, "/v1/connections/connection_id").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						var responseData = tfmock.CreateMapFromJsonString(t, `
+						{
+							"id": "connection_id",
+							"group_id": "group_id",
+							"service": "postgres",
+							"schema": "postgres",
+							"paused": true,
+							"status": {
+								"setup_state": "broken",
+								"sync_state": "paused",
+								"update_state": "delayed",
+								"is_historical_sync": false,
+								"tasks": [
+									{"code": "reconnect", "message": "Reconnect"}
+								],
+								"warnings": [
+									{"code": "snowflake_discontinuing_password_auth", "message": "Password authentication is deprecated"}
+								]
+							},
+							"config": {}
+						}`)
+						return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Success", responseData), nil
+					},
+				)
+			},
+			ProtoV6ProviderFactories: tfmock.ProtoV6ProviderFactories,
+			CheckDestroy: func(s *terraform.State) error {
+				return nil
+			},
+			Steps: []resource.TestStep{
+				step1,
+			},
+		},
+	)
+}
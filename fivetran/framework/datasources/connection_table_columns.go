@@ -0,0 +1,65 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func ConnectionTableColumns() datasource.DataSource {
+	return &connectionTableColumns{}
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ datasource.DataSourceWithConfigure = &connectionTableColumns{}
+
+type connectionTableColumns struct {
+	core.ProviderDatasource
+}
+
+func (d *connectionTableColumns) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "fivetran_connection_table_columns"
+}
+
+func (d *connectionTableColumns) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = fivetranSchema.ConnectionTableColumnsDatasource()
+}
+
+func (d *connectionTableColumns) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.ConnectionTableColumns
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := d.GetClient().NewConnectionColumnConfigListService().
+		ConnectionId(data.ConnectionId.ValueString()).
+		Schema(data.Schema.ValueString()).
+		Table(data.Table.ValueString()).
+		Do(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Connection Table Columns Read Error.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, response.Code, response.Message),
+		)
+		return
+	}
+
+	data.ReadFromResponse(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,89 @@
+package datasources_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/fivetran/go-fivetran/tests/mock"
+	tfmock "github.com/fivetran/terraform-provider-fivetran/fivetran/tests/mock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestDataSourceConnectionTableColumnsMappingMock(t *testing.T) {
+	var columnsGetHandler *mock.Handler
+
+	step1 := resource.TestStep{
+		Config: `
+		data "fivetran_connection_table_columns" "test_data" {
+			provider = fivetran-provider
+			connection_id = "connection_id"
+			schema = "schema_1"
+			table = "table_1"
+		}`,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				tfmock.AssertEqual(t, columnsGetHandler.Interactions, 1)
+				return nil
+			},
+			resource.TestCheckResourceAttr("data.fivetran_connection_table_columns.test_data", "id", "connection_id/schema_1/table_1"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_table_columns.test_data", "columns.%", "2"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_table_columns.test_data", "columns.column_1.name_in_destination", "column_1"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_table_columns.test_data", "columns.column_1.is_primary_key", "true"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_table_columns.test_data", "columns.column_1.patch_allowed", "false"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_table_columns.test_data", "columns.column_1.lock_reason_code", "SYSTEM_COLUMN"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_table_columns.test_data", "columns.column_2.enabled", "false"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_table_columns.test_data", "columns.column_2.hashed", "true"),
+			resource.TestCheckResourceAttr("data.fivetran_connection_table_columns.test_data", "columns.column_2.patch_allowed", "true"),
+		),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				tfmock.MockClient().Reset()
+
+				columnsGetHandler = tfmock.MockClient().When(http.MethodGet, "/v1/connections/connection_id/schemas/schema_1/tables/table_1/columns").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						var responseData = tfmock.CreateMapFromJsonString(t, `
+						{
+							"columns": {
+								"column_1": {
+									"name_in_destination": "column_1",
+									"enabled": true,
+									"hashed": false,
+									"is_primary_key": true,
+									"enabled_patch_settings": {
+										"allowed": false,
+										"reason_code": "SYSTEM_COLUMN",
+										"reason": "The column does not support exclusion as it is a Primary Key"
+									}
+								},
+								"column_2": {
+									"name_in_destination": "column_2",
+									"enabled": false,
+									"hashed": true,
+									"is_primary_key": false,
+									"enabled_patch_settings": {
+										"allowed": true
+									}
+								}
+							}
+						}
+						`)
+						return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Success", responseData), nil
+					},
+				)
+			},
+			ProtoV6ProviderFactories: tfmock.ProtoV6ProviderFactories,
+			CheckDestroy: func(s *terraform.State) error {
+				return nil
+			},
+			Steps: []resource.TestStep{
+				step1,
+			},
+		},
+	)
+}
@@ -44,44 +44,38 @@ func (d *connections) Read(ctx context.Context, req datasource.ReadRequest, resp
 	var data model.Connections
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-	var respNextCursor string
-	var listResponse sdk.ConnectionsListResponse
-	limit := 1000
+	filter, diags := model.NewListFilter(data.Filter)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	for {
-		var err error
-		var tmpResp sdk.ConnectionsListResponse
-		svc := d.GetClient().NewConnectionsList()
-		
-        svc.Limit(limit)
-        if respNextCursor != "" {
-            svc.Cursor(respNextCursor)
-        }
-		if !data.GroupId.IsNull() {
-			svc.GroupID(data.GroupId.ValueString())
-		}
-		if !data.SchemaName.IsNull() {
-			svc.Schema(data.SchemaName.ValueString())
-		}
-		tmpResp, err = svc.Do(ctx)
+	listResponse, err := core.ListConnections(ctx, d.GetClient(), data.GroupId.ValueString(), data.SchemaName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Read error.",
+			fmt.Sprintf("%v; code: %v", err, listResponse.Code),
+		)
+		listResponse = sdk.ConnectionsListResponse{}
+	}
+	listResponse.Data.Items = model.FilterList(filter, listResponse.Data.Items, model.ConnectionFilterFields)
 
+	var details []sdk.DetailsWithCustomConfigNoTestsResponse
+	if data.IncludeDetails.ValueBool() {
+		ids := make([]string, 0, len(listResponse.Data.Items))
+		for _, item := range listResponse.Data.Items {
+			ids = append(ids, item.ID)
+		}
+		details, err = core.ListConnectionDetails(ctx, d.GetClient(), ids, d.GetFetchConcurrency())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Read error.",
-				fmt.Sprintf("%v; code: %v", err, tmpResp.Code),
+				fmt.Sprintf("Unable to read the connection details: %v", err),
 			)
-			listResponse = sdk.ConnectionsListResponse{}
-		}
-
-		listResponse.Data.Items = append(listResponse.Data.Items, tmpResp.Data.Items...)
-
-		if tmpResp.Data.NextCursor == "" {
-			break
+			return
 		}
-
-		respNextCursor = tmpResp.Data.NextCursor
 	}
-	data.ReadFromResponse(ctx, listResponse)
+	data.ReadFromResponse(ctx, listResponse, details)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
\ No newline at end of file
@@ -217,3 +217,74 @@ func TestDataSourceConnectionsFilteringByGroupIdAndSchema(t *testing.T) {
 		},
 	)
 }
+
+func setupMockClientConnectionsDataSourceIncludeDetails(t *testing.T) {
+	tfmock.MockClient().Reset()
+
+	connectionsDataSourceMockGetHandler = tfmock.MockClient().When(http.MethodGet, "/v1/connections").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			connectionsDataSourceMockData = tfmock.CreateMapFromJsonString(t, connectionsMappingResponse)
+			return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Success", connectionsDataSourceMockData), nil
+		},
+	)
+
+	tfmock.MockClient().When(http.MethodGet, "/v1/connections/connection_id").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Success", tfmock.CreateMapFromJsonString(t, `
+			{
+				"id": "connection_id",
+				"service": "string",
+				"schema": "gsheets.table",
+				"group_id": "group_id",
+				"config": {"sheet_id": "sheet_id"},
+				"status": {
+					"setup_state": "broken",
+					"sync_state": "paused",
+					"update_state": "delayed",
+					"is_historical_sync": false,
+					"tasks": [{"code": "reconnect", "message": "Reconnect"}],
+					"warnings": []
+				}
+			}`)), nil
+		},
+	)
+}
+
+func TestDataSourceConnectionsIncludeDetails(t *testing.T) {
+	step1 := resource.TestStep{
+		Config: `
+		data "fivetran_connections" "test3" {
+			provider = fivetran-provider
+			include_details = true
+
+			filter {
+				service = "string"
+			}
+		}`,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("data.fivetran_connections.test3", "connections.#", "1"),
+			resource.TestCheckResourceAttr("data.fivetran_connections.test3", "connections.0.id", "connection_id"),
+			resource.TestCheckResourceAttr("data.fivetran_connections.test3", "connections.0.config", `{"sheet_id":"sheet_id"}`),
+			resource.TestCheckResourceAttr("data.fivetran_connections.test3", "connections.0.status.setup_state", "broken"),
+			resource.TestCheckResourceAttr("data.fivetran_connections.test3", "connections.0.status.tasks.0.code", "reconnect"),
+			resource.TestCheckResourceAttr("data.fivetran_connections.test3", "connections.0.status.warnings.#", "0"),
+		),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientConnectionsDataSourceIncludeDetails(t)
+			},
+			ProtoV6ProviderFactories: tfmock.ProtoV6ProviderFactories,
+			CheckDestroy: func(s *terraform.State) error {
+				return nil
+			},
+			Steps: []resource.TestStep{
+				step1,
+			},
+		},
+	)
+}
@@ -44,36 +44,13 @@ func (d *connectors) Read(ctx context.Context, req datasource.ReadRequest, resp
 	var data model.Connectors
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-	var respNextCursor string
-	var connectorsResponse sdk.ConnectionsListResponse
-	limit := 1000
-
-	for {
-		var err error
-		var tmpResp sdk.ConnectionsListResponse
-		svc := d.GetClient().NewConnectionsList()
-		
-        svc.Limit(limit)
-        if respNextCursor != "" {
-            svc.Cursor(respNextCursor)
-        }
-        tmpResp, err = svc.Do(ctx)
-		
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Read error.",
-				fmt.Sprintf("%v; code: %v", err, tmpResp.Code),
-			)
-			connectorsResponse = sdk.ConnectionsListResponse{}
-		}
-
-		connectorsResponse.Data.Items = append(connectorsResponse.Data.Items, tmpResp.Data.Items...)
-
-		if tmpResp.Data.NextCursor == "" {
-			break
-		}
-
-		respNextCursor = tmpResp.Data.NextCursor
+	connectorsResponse, err := core.ListConnections(ctx, d.GetClient(), "", "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Read error.",
+			fmt.Sprintf("%v; code: %v", err, connectorsResponse.Code),
+		)
+		connectorsResponse = sdk.ConnectionsListResponse{}
 	}
 	data.ReadFromResponse(ctx, connectorsResponse)
 
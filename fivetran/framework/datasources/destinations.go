@@ -44,38 +44,22 @@ func (d *destinations) Read(ctx context.Context, req datasource.ReadRequest, res
 	var data model.Destinations
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-	var respNextCursor string
-	var destinationsResponse sdk.DestinationsListResponse
-	limit := 1000
-
-	for {
-		var err error
-		var tmpResp sdk.DestinationsListResponse
-		svc := d.GetClient().NewDestinationsList()
-		
-        svc.Limit(limit)
-        if respNextCursor != "" {
-            svc.Cursor(respNextCursor)
-        }
-        tmpResp, err = svc.Do(ctx)
-		
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Read error.",
-				fmt.Sprintf("%v; code: %v", err, tmpResp.Code),
-			)
-			destinationsResponse = sdk.DestinationsListResponse{}
-		}
-
-		destinationsResponse.Data.Items = append(destinationsResponse.Data.Items, tmpResp.Data.Items...)
-
-		if tmpResp.Data.NextCursor == "" {
-			break
-		}
+	filter, diags := model.NewListFilter(data.Filter)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-		respNextCursor = tmpResp.Data.NextCursor
+	destinationsResponse, err := core.ListDestinations(ctx, d.GetClient())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Read error.",
+			fmt.Sprintf("%v; code: %v", err, destinationsResponse.Code),
+		)
+		destinationsResponse = sdk.DestinationsListResponse{}
 	}
 
+	destinationsResponse.Data.Items = model.FilterList(filter, destinationsResponse.Data.Items, model.DestinationFilterFields)
 	data.ReadFromResponse(ctx, destinationsResponse)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
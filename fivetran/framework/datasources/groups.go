@@ -44,38 +44,22 @@ func (d *groups) Read(ctx context.Context, req datasource.ReadRequest, resp *dat
 	var data model.Groups
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-	var respNextCursor string
-	var groupsResponse sdk.GroupsListResponse
-	limit := 1000
-
-	for {
-		var err error
-		var tmpResp sdk.GroupsListResponse
-		svc := d.GetClient().NewGroupsList()
-		
-        svc.Limit(limit)
-        if respNextCursor != "" {
-            svc.Cursor(respNextCursor)
-        }
-        tmpResp, err = svc.Do(ctx)
-		
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Read error.",
-				fmt.Sprintf("%v; code: %v", err, tmpResp.Code),
-			)
-			groupsResponse = sdk.GroupsListResponse{}
-		}
-
-		groupsResponse.Data.Items = append(groupsResponse.Data.Items, tmpResp.Data.Items...)
-
-		if tmpResp.Data.NextCursor == "" {
-			break
-		}
+	filter, diags := model.NewListFilter(data.Filter)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-		respNextCursor = tmpResp.Data.NextCursor
+	groupsResponse, err := core.ListGroups(ctx, d.GetClient())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Read error.",
+			fmt.Sprintf("%v; code: %v", err, groupsResponse.Code),
+		)
+		groupsResponse = sdk.GroupsListResponse{}
 	}
 
+	groupsResponse.Data.Items = model.FilterList(filter, groupsResponse.Data.Items, model.GroupFilterFields)
 	data.ReadFromResponse(ctx, groupsResponse)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
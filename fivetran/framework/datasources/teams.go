@@ -44,38 +44,22 @@ func (d *teams) Read(ctx context.Context, req datasource.ReadRequest, resp *data
 	var data model.Teams
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-	var respNextCursor string
-	var listResponse sdk.TeamsListResponse
-	limit := 1000
-
-	for {
-		var err error
-		var tmpResp sdk.TeamsListResponse
-		svc := d.GetClient().NewTeamsList()
-		
-        svc.Limit(limit)
-        if respNextCursor != "" {
-            svc.Cursor(respNextCursor)
-        }
-        tmpResp, err = svc.Do(ctx)
-		
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Read error.",
-				fmt.Sprintf("%v; code: %v", err, tmpResp.Code),
-			)
-			listResponse = sdk.TeamsListResponse{}
-		}
-
-		listResponse.Data.Items = append(listResponse.Data.Items, tmpResp.Data.Items...)
-
-		if tmpResp.Data.NextCursor == "" {
-			break
-		}
+	filter, diags := model.NewListFilter(data.Filter)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-		respNextCursor = tmpResp.Data.NextCursor
+	listResponse, err := core.ListTeams(ctx, d.GetClient())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Read error.",
+			fmt.Sprintf("%v; code: %v", err, listResponse.Code),
+		)
+		listResponse = sdk.TeamsListResponse{}
 	}
 
+	listResponse.Data.Items = model.FilterList(filter, listResponse.Data.Items, model.TeamFilterFields)
 	data.ReadFromResponse(ctx, listResponse)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -43,36 +43,13 @@ func (d *transformations) Read(ctx context.Context, req datasource.ReadRequest,
 	var data model.Transformations
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-	var respNextCursor string
-	var listResponse sdk.TransformationsListResponse
-	limit := 1000
-
-	for {
-		var err error
-		var tmpResp sdk.TransformationsListResponse
-		svc := d.GetClient().NewTransformationsList()
-
-        svc.Limit(limit)
-        if respNextCursor != "" {
-            svc.Cursor(respNextCursor)
-        }
-        tmpResp, err = svc.Do(ctx)
-
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Read error.",
-				fmt.Sprintf("%v; code: %v", err, tmpResp.Code),
-			)
-			listResponse = sdk.TransformationsListResponse{}
-		}
-
-		listResponse.Data.Items = append(listResponse.Data.Items, tmpResp.Data.Items...)
-
-		if tmpResp.Data.NextCursor == "" {
-			break
-		}
-
-		respNextCursor = tmpResp.Data.NextCursor
+	listResponse, err := core.ListTransformations(ctx, d.GetClient())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Read error.",
+			fmt.Sprintf("%v; code: %v", err, listResponse.Code),
+		)
+		listResponse = sdk.TransformationsListResponse{}
 	}
 
 	data.ReadFromResponse(ctx, listResponse)
@@ -44,38 +44,22 @@ func (d *webhooks) Read(ctx context.Context, req datasource.ReadRequest, resp *d
 	var data model.Webhooks
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-	var respNextCursor string
-	var webhookResponse webhooksSdk.WebhookListResponse
-	limit := 1000
-
-	for {
-		var err error
-		var tmpResp webhooksSdk.WebhookListResponse
-		svc := d.GetClient().NewWebhookList()
-		
-        svc.Limit(limit)
-        if respNextCursor != "" {
-            svc.Cursor(respNextCursor)
-        }
-        tmpResp, err = svc.Do(ctx)
-		
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Read error.",
-				fmt.Sprintf("%v; code: %v", err, tmpResp.Code),
-			)
-			webhookResponse = webhooksSdk.WebhookListResponse{}
-		}
-
-		webhookResponse.Data.Items = append(webhookResponse.Data.Items, tmpResp.Data.Items...)
-
-		if tmpResp.Data.NextCursor == "" {
-			break
-		}
+	filter, diags := model.NewListFilter(data.Filter)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-		respNextCursor = tmpResp.Data.NextCursor
+	webhookResponse, err := core.ListWebhooks(ctx, d.GetClient())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Read error.",
+			fmt.Sprintf("%v; code: %v", err, webhookResponse.Code),
+		)
+		webhookResponse = webhooksSdk.WebhookListResponse{}
 	}
 
+	webhookResponse.Data.Items = model.FilterList(filter, webhookResponse.Data.Items, model.WebhookFilterFields)
 	data.ReadFromResponse(ctx, webhookResponse)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
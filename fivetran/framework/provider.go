@@ -12,11 +12,16 @@ import (
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/datasources"
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/resources"
+	configSchema "github.com/fivetran/terraform-provider-fivetran/modules/connector/schema"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -28,10 +33,14 @@ type fivetranProvider struct {
 }
 
 type fivetranProviderModel struct {
-	ApiKey                 types.String `tfsdk:"api_key"`
-	ApiSecret              types.String `tfsdk:"api_secret"`
-	ApiUrl                 types.String `tfsdk:"api_url"`
-	SkipPlanTimeValidation types.Bool   `tfsdk:"skip_plan_time_validation"`
+	ApiKey                        types.String `tfsdk:"api_key"`
+	ApiSecret                     types.String `tfsdk:"api_secret"`
+	ApiUrl                        types.String `tfsdk:"api_url"`
+	SkipPlanTimeValidation        types.Bool   `tfsdk:"skip_plan_time_validation"`
+	FieldStatusPolicy             types.String `tfsdk:"field_status_policy"`
+	FailOnSetupTestFailure        types.Bool   `tfsdk:"fail_on_setup_test_failure"`
+	FetchConcurrency              types.Int64  `tfsdk:"fetch_concurrency"`
+	SchemaColumnsFetchConcurrency types.Int64  `tfsdk:"schema_columns_fetch_concurrency"`
 }
 
 func FivetranProvider() provider.Provider {
@@ -65,6 +74,32 @@ func (p *fivetranProvider) Schema(ctx context.Context, req provider.SchemaReques
 				Optional:    true,
 				Description: "Skip metadata-backed plan-time validation for dynamic v2 resource fields. Use only as a temporary workaround when validation metadata is not available; invalid fields will fail later at apply time.",
 			},
+			"field_status_policy": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(core.FieldStatusPolicyWarn, core.FieldStatusPolicyError, core.FieldStatusPolicyIgnore),
+				},
+				Description: "How plan-time validation treats config fields which connector metadata marks as `development`, `private_preview` or `sunset`: `warn` (default), `error` or `ignore`.",
+			},
+			"fail_on_setup_test_failure": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Default value of `fail_on_setup_test_failure` for `fivetran_connector`, `fivetran_connection_v2` and `fivetran_destination` resources: report setup tests that are neither PASSED nor SKIPPED as errors instead of warnings. Default: false.",
+			},
+			"fetch_concurrency": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 32),
+				},
+				Description: "Maximum number of parallel requests the provider makes when it fetches many objects one by one: table columns of `fivetran_connector_schema_config` with `validation_level = \"COLUMNS\"` and connection details of `fivetran_connections` with `include_details`. Default: 4.",
+			},
+			"schema_columns_fetch_concurrency": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 32),
+				},
+				DeprecationMessage: "Use `fetch_concurrency` instead. This attribute is ignored if `fetch_concurrency` is set.",
+				Description:        "Deprecated alias of `fetch_concurrency`, ignored if `fetch_concurrency` is set.",
+			},
 		},
 	}
 }
@@ -93,6 +128,22 @@ func (p *fivetranProvider) Configure(ctx context.Context, req provider.Configure
 	if !data.SkipPlanTimeValidation.IsNull() && !data.SkipPlanTimeValidation.IsUnknown() {
 		skipPlanTimeValidation = data.SkipPlanTimeValidation.ValueBool()
 	}
+	fieldStatusPolicy := core.FieldStatusPolicyWarn
+	if !data.FieldStatusPolicy.IsNull() && !data.FieldStatusPolicy.IsUnknown() {
+		fieldStatusPolicy = data.FieldStatusPolicy.ValueString()
+	}
+
+	failOnSetupTestFailure := false
+	if !data.FailOnSetupTestFailure.IsNull() && !data.FailOnSetupTestFailure.IsUnknown() {
+		failOnSetupTestFailure = data.FailOnSetupTestFailure.ValueBool()
+	}
+
+	fetchConcurrency := configSchema.DefaultColumnFetchConcurrency
+	if !data.FetchConcurrency.IsNull() && !data.FetchConcurrency.IsUnknown() {
+		fetchConcurrency = int(data.FetchConcurrency.ValueInt64())
+	} else if !data.SchemaColumnsFetchConcurrency.IsNull() && !data.SchemaColumnsFetchConcurrency.IsUnknown() {
+		fetchConcurrency = int(data.SchemaColumnsFetchConcurrency.ValueInt64())
+	}
 
 	// Init client
 	fivetranClient := fivetran.New(apiKey, apiSecret)
@@ -106,13 +157,22 @@ func (p *fivetranProvider) Configure(ctx context.Context, req provider.Configure
 	}
 
 	fivetranClient.CustomUserAgent("terraform-provider-fivetran/" + Version)
-	resp.DataSourceData = fivetranClient
-	resp.ResourceData = &core.ProviderResourceData{
+	resourceData := &core.ProviderResourceData{
 		Client:                 fivetranClient,
 		MetadataCache:          p.metadataCache,
 		SkipPlanTimeValidation: skipPlanTimeValidation,
+		FieldStatusPolicy:      fieldStatusPolicy,
+		FailOnSetupTestFailure: failOnSetupTestFailure,
+		ColumnFetcher:          configSchema.NewColumnFetcher(fetchConcurrency),
+		FetchConcurrency:       fetchConcurrency,
+		TableOwnership:         configSchema.NewTableOwnership(),
 	}
-	resp.ActionData = fivetranClient
+	resp.DataSourceData = resourceData
+	resp.ResourceData = resourceData
+	// actions share the resource data, so they can drop schema columns cached for resources
+	resp.ActionData = resourceData
+	// list resources are implemented by the managed resources, so they share the resource data as well
+	resp.ListResourceData = resourceData
 }
 
 func (p *fivetranProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -125,6 +185,7 @@ func (p *fivetranProvider) Resources(ctx context.Context) []func() resource.Reso
 		resources.Connection,
 		resources.ConnectionConfig,
 		resources.ConnectorSchema,
+		resources.ConnectorSchemaTable,
 		resources.ConnectorSchedule,
 		resources.Destination,
 		resources.Team,
@@ -147,9 +208,23 @@ func (p *fivetranProvider) Resources(ctx context.Context) []func() resource.Reso
 	}
 }
 
+func (p *fivetranProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		resources.ConnectorList,
+		resources.ConnectionList,
+		resources.DestinationList,
+		resources.GroupList,
+		resources.UserList,
+		resources.TeamList,
+		resources.WebhookList,
+		resources.TransformationList,
+	}
+}
+
 func (p *fivetranProvider) Actions(ctx context.Context) []func() action.Action {
 	return []func() action.Action{
 		actions.TransformationProjectRunTests,
+		actions.ConnectionSchemaReload,
 	}
 }
 
@@ -165,6 +240,9 @@ func (p *fivetranProvider) DataSources(ctx context.Context) []func() datasource.
 		datasources.GroupSshKey,
 		datasources.GroupServiceAccount,
 		datasources.Connection,
+		datasources.ConnectionSchema,
+		datasources.ConnectionTableColumns,
+		datasources.ConnectionStatus,
 		datasources.Connector,
 		datasources.Destination,
 		datasources.Team,
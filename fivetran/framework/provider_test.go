@@ -5,8 +5,13 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	providerSchema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 func TestProviderSchemaIncludesSkipPlanTimeValidation(t *testing.T) {
@@ -27,3 +32,144 @@ func TestProviderSchemaIncludesSkipPlanTimeValidation(t *testing.T) {
 		t.Fatalf("skip_plan_time_validation mode = required:%v optional:%v, want optional only", attr.Required, attr.Optional)
 	}
 }
+
+func TestProviderSchemaIncludesFieldStatusPolicy(t *testing.T) {
+	t.Parallel()
+
+	p := &fivetranProvider{metadataCache: &sync.Map{}}
+	var resp provider.SchemaResponse
+	p.Schema(context.Background(), provider.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("schema diagnostics: %v", resp.Diagnostics)
+	}
+
+	attr, ok := resp.Schema.Attributes["field_status_policy"].(providerSchema.StringAttribute)
+	if !ok {
+		t.Fatalf("field_status_policy has type %T, want StringAttribute", resp.Schema.Attributes["field_status_policy"])
+	}
+	if !attr.Optional || attr.Required {
+		t.Fatalf("field_status_policy mode = required:%v optional:%v, want optional only", attr.Required, attr.Optional)
+	}
+	if len(attr.Validators) != 1 {
+		t.Fatalf("field_status_policy validators = %d, want 1", len(attr.Validators))
+	}
+}
+
+func TestProviderConfigureFetchConcurrency(t *testing.T) {
+	t.Parallel()
+
+	p := &fivetranProvider{metadataCache: &sync.Map{}}
+	var schemaResp provider.SchemaResponse
+	p.Schema(context.Background(), provider.SchemaRequest{}, &schemaResp)
+
+	for _, tc := range []struct {
+		fetchConcurrency              interface{}
+		schemaColumnsFetchConcurrency interface{}
+		expected                      int
+	}{
+		{nil, nil, 4},
+		{8, nil, 8},
+		{nil, 6, 6},
+		{8, 6, 8},
+	} {
+		objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+		values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+		for name, attributeType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+		values["api_key"] = tftypes.NewValue(tftypes.String, "key")
+		values["api_secret"] = tftypes.NewValue(tftypes.String, "secret")
+		values["fetch_concurrency"] = tftypes.NewValue(tftypes.Number, tc.fetchConcurrency)
+		values["schema_columns_fetch_concurrency"] = tftypes.NewValue(tftypes.Number, tc.schemaColumnsFetchConcurrency)
+
+		var resp provider.ConfigureResponse
+		p.Configure(context.Background(), provider.ConfigureRequest{Config: tfsdk.Config{
+			Raw:    tftypes.NewValue(objectType, values),
+			Schema: schemaResp.Schema,
+		}}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("configure diagnostics: %v", resp.Diagnostics)
+		}
+
+		if actual := resp.DataSourceData.(*core.ProviderResourceData).FetchConcurrency; actual != tc.expected {
+			t.Errorf("fetch_concurrency %v, schema_columns_fetch_concurrency %v: FetchConcurrency = %v, want %v",
+				tc.fetchConcurrency, tc.schemaColumnsFetchConcurrency, actual, tc.expected)
+		}
+	}
+}
+
+func TestProviderResourceIdentitySchemas(t *testing.T) {
+	t.Parallel()
+
+	server := providerserver.NewProtocol6(FivetranProvider())()
+	resp, err := server.GetResourceIdentitySchemas(context.Background(), &tfprotov6.GetResourceIdentitySchemasRequest{})
+	if err != nil {
+		t.Fatalf("GetResourceIdentitySchemas error: %v", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Errorf("identity schema diagnostic: %v: %v", d.Summary, d.Detail)
+	}
+
+	for resourceType, expected := range map[string][]string{
+		"fivetran_connector":                 {"id", "group_id", "schema_name"},
+		"fivetran_connection":                {"id", "group_id", "schema_name"},
+		"fivetran_destination":               {"id"},
+		"fivetran_group":                     {"id"},
+		"fivetran_user":                      {"id"},
+		"fivetran_team":                      {"id"},
+		"fivetran_webhook":                   {"id"},
+		"fivetran_hybrid_deployment_agent":   {"id"},
+		"fivetran_proxy_agent":               {"id"},
+		"fivetran_transformation":            {"id"},
+		"fivetran_transformation_project":    {"id"},
+		"fivetran_team_group_membership":     {"team_id"},
+		"fivetran_team_connector_membership": {"team_id"},
+		"fivetran_team_user_membership":      {"team_id"},
+	} {
+		identitySchema, ok := resp.IdentitySchemas[resourceType]
+		if !ok {
+			t.Errorf("%v has no identity schema", resourceType)
+			continue
+		}
+		attributes := map[string]bool{}
+		for _, attribute := range identitySchema.IdentityAttributes {
+			attributes[attribute.Name] = true
+		}
+		for _, name := range expected {
+			if !attributes[name] {
+				t.Errorf("%v identity has no %v attribute", resourceType, name)
+			}
+		}
+		if len(attributes) != len(expected) {
+			t.Errorf("%v identity attributes = %v, want %v", resourceType, attributes, expected)
+		}
+	}
+}
+
+func TestProviderListResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	server := providerserver.NewProtocol6(FivetranProvider())()
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema error: %v", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Errorf("provider schema diagnostic: %v: %v", d.Summary, d.Detail)
+	}
+
+	for _, resourceType := range []string{
+		"fivetran_connector",
+		"fivetran_connection",
+		"fivetran_destination",
+		"fivetran_group",
+		"fivetran_user",
+		"fivetran_team",
+		"fivetran_webhook",
+		"fivetran_transformation",
+	} {
+		if _, ok := resp.ListResourceSchemas[resourceType]; !ok {
+			t.Errorf("%v has no list resource schema", resourceType)
+		}
+	}
+}
@@ -8,7 +8,7 @@ import (
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
 	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -25,6 +25,7 @@ type connection struct {
 // Ensure the implementation satisfies the desired interfaces.
 var _ resource.ResourceWithConfigure = &connection{}
 var _ resource.ResourceWithImportState = &connection{}
+var _ resource.ResourceWithIdentity = &connection{}
 
 func (r *connection) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_connection"
@@ -38,8 +39,13 @@ func (r *connection) Schema(ctx context.Context, req resource.SchemaRequest, res
 	}
 }
 
+func (r *connection) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = fivetranSchema.ConnectionIdentitySchema()
+}
+
 func (r *connection) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id, err := resolveConnectionImport(ctx, r.GetClient(), req)
+	importResolvedId(ctx, resp, "Unable to Import Connection Resource.", id, err, "id")
 }
 
 func (r *connection) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -94,6 +100,26 @@ func (r *connection) Create(ctx context.Context, req resource.CreateRequest, res
 	runSetupTestsPlan := core.GetBoolOrDefault(data.RunSetupTests, false)
 	trustCertificatesPlan := core.GetBoolOrDefault(data.TrustCertificates, false)
 	trustFingerprintsPlan := core.GetBoolOrDefault(data.TrustFingerprints, false)
+	adoptExistingPlan := core.GetBoolOrDefault(data.AdoptExisting, false)
+
+	if adoptExistingPlan {
+		existingId, err := findConnectionIdBySchema(ctx, r.GetClient(), data.GroupId.ValueString(), connectorSchemaName(destinationSchema))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Create Connection Resource.",
+				fmt.Sprintf("Error while looking up the connection to adopt. %v", err),
+			)
+			return
+		}
+		if existingId != "" {
+			if r.adopt(ctx, &data, existingId, &resp.Diagnostics) {
+				data.AdoptExisting = types.BoolValue(adoptExistingPlan)
+				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, connectionIdentity(data.Id, data.GroupId, data.Name))...)
+			}
+			return
+		}
+	}
 
 	svc := r.GetClient().NewConnectionCreate().
 		Paused(true). // on creation we always create paused connection
@@ -146,6 +172,7 @@ func (r *connection) Create(ctx context.Context, req resource.CreateRequest, res
 	data.RunSetupTests = types.BoolValue(runSetupTestsPlan)
 	data.TrustCertificates = types.BoolValue(trustCertificatesPlan)
 	data.TrustFingerprints = types.BoolValue(trustFingerprintsPlan)
+	data.AdoptExisting = types.BoolValue(adoptExistingPlan)
 
 	if runSetupTestsPlan && response.Data.SetupTests != nil && len(response.Data.SetupTests) > 0 {
 		for _, tr := range response.Data.SetupTests {
@@ -159,6 +186,7 @@ func (r *connection) Create(ctx context.Context, req resource.CreateRequest, res
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, connectionIdentity(data.Id, data.GroupId, data.Name))...)
 }
 
 func (r *connection) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -192,6 +220,7 @@ func (r *connection) Read(ctx context.Context, req resource.ReadRequest, resp *r
 
 	data.ReadFromResponse(response)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, connectionIdentity(data.Id, data.GroupId, data.Name))...)
 }
 
 func (r *connection) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -209,6 +238,19 @@ func (r *connection) Update(ctx context.Context, req resource.UpdateRequest, res
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	if !r.applyUpdate(ctx, &plan, state, &resp.Diagnostics) {
+		return
+	}
+	plan.AdoptExisting = types.BoolValue(core.GetBoolOrDefault(plan.AdoptExisting, false))
+
+	// Save state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, connectionIdentity(plan.Id, plan.GroupId, plan.Name))...)
+}
+
+// applyUpdate patches the connection in state to the plan and reads the result into the plan.
+// Returns false if the connection couldn't be updated, the state has to be kept as is then.
+func (r *connection) applyUpdate(ctx context.Context, plan *model.ConnectionResourceModel, state model.ConnectionResourceModel, diags *diag.Diagnostics) bool {
 	runSetupTestsPlan := core.GetBoolOrDefault(plan.RunSetupTests, false)
 	trustCertificatesPlan := core.GetBoolOrDefault(plan.TrustCertificates, false)
 	trustFingerprintsPlan := core.GetBoolOrDefault(plan.TrustFingerprints, false)
@@ -247,18 +289,18 @@ func (r *connection) Update(ctx context.Context, req resource.UpdateRequest, res
 	response, err := svc.DoCustom(ctx)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		diags.AddError(
 			"Unable to Update Connection Resource.",
 			fmt.Sprintf("%v; code: %v; message: %v", err, response.Code, response.Message),
 		)
-		return
+		return false
 	}
 	plan.ReadFromCreateResponse(response)
 
 	if runSetupTestsPlan && response.Data.SetupTests != nil && len(response.Data.SetupTests) > 0 {
 		for _, tr := range response.Data.SetupTests {
 			if tr.Status != "PASSED" && tr.Status != "SKIPPED" {
-				resp.Diagnostics.AddWarning(
+				diags.AddWarning(
 					fmt.Sprintf("Connection setup test `%v` has status `%v`", tr.Title, tr.Status),
 					tr.Message,
 				)
@@ -268,11 +310,11 @@ func (r *connection) Update(ctx context.Context, req resource.UpdateRequest, res
 
 	details, err := r.GetClient().NewConnectionDetails().ConnectionID(state.Id.ValueString()).DoCustom(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		diags.AddError(
 			"Unable to Read after Update Connection Resource.",
 			fmt.Sprintf("%v; code: %v; message: %v", err, details.Code, details.Message),
 		)
-		return
+		return false
 	}
 	plan.ReadFromResponse(details)
 
@@ -286,9 +328,33 @@ func (r *connection) Update(ctx context.Context, req resource.UpdateRequest, res
 	if plan.TrustFingerprints.IsUnknown() {
 		plan.TrustFingerprints = state.TrustFingerprints
 	}
+	return true
+}
 
-	// Save state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+// adopt reads the existing connection into the state and updates it to the plan. Returns false if it couldn't be adopted.
+func (r *connection) adopt(ctx context.Context, plan *model.ConnectionResourceModel, id string, diags *diag.Diagnostics) bool {
+	response, err := r.GetClient().NewConnectionDetails().ConnectionID(id).DoCustom(ctx)
+	if err != nil {
+		diags.AddError(
+			"Unable to Adopt Connection Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, response.Code, response.Message),
+		)
+		return false
+	}
+	if response.Data.Service != plan.Service.ValueString() {
+		diags.AddError(
+			"Unable to Adopt Connection Resource.",
+			fmt.Sprintf("Connection %v with schema `%v` has service `%v`, not `%v`.", id, response.Data.Schema, response.Data.Service, plan.Service.ValueString()),
+		)
+		return false
+	}
+
+	state := *plan
+	state.ReadFromResponse(response)
+	state.RunSetupTests = types.BoolNull()
+	state.TrustCertificates = types.BoolNull()
+	state.TrustFingerprints = types.BoolNull()
+	return r.applyUpdate(ctx, plan, state, diags)
 }
 
 func (r *connection) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
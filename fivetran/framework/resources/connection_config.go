@@ -7,7 +7,6 @@ import (
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
 	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
@@ -33,7 +32,8 @@ func (r *connectionConfig) Schema(ctx context.Context, req resource.SchemaReques
 }
 
 func (r *connectionConfig) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("connection_id"), req, resp)
+	id, err := resolveConnectionImportId(ctx, r.GetClient(), req.ID)
+	importResolvedId(ctx, resp, "Unable to Import Connection Config Resource.", id, err, "connection_id")
 }
 
 func (r *connectionConfig) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
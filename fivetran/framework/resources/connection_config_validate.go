@@ -0,0 +1,57 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.ResourceWithValidateConfig = &connectionConfig{}
+
+func (r *connectionConfig) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if r.GetSkipPlanTimeValidation() || r.GetClient() == nil {
+		return
+	}
+
+	var data model.ConnectionConfigModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ConnectionId.IsNull() || data.ConnectionId.IsUnknown() || data.ConnectionId.ValueString() == "" {
+		return
+	}
+
+	// Malformed JSON is reported by the attribute type itself.
+	var configMap, authMap map[string]interface{}
+	if !data.Config.IsNull() && !data.Config.IsUnknown() && data.Config.ValueString() != "" {
+		if err := json.Unmarshal([]byte(data.Config.ValueString()), &configMap); err != nil {
+			return
+		}
+	}
+	if !data.Auth.IsNull() && !data.Auth.IsUnknown() && data.Auth.ValueString() != "" {
+		if err := json.Unmarshal([]byte(data.Auth.ValueString()), &authMap); err != nil {
+			return
+		}
+	}
+	if len(configMap) == 0 && len(authMap) == 0 {
+		return
+	}
+
+	// The resource has no service attribute, the connection itself tells which metadata applies.
+	service, err := core.GetCachedConnectionService(ctx, r.GetClient(), r.GetMetadataCache(), data.ConnectionId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Validate Connection Config",
+			fmt.Sprintf("Unable to read connection %v, config and auth fields were not validated: %v", data.ConnectionId.ValueString(), err),
+		)
+		return
+	}
+
+	validateLegacyConfigAgainstMetadata(ctx, &r.ProviderResource, service, configMap, authMap, &resp.Diagnostics)
+}
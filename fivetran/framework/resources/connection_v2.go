@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"sync"
 
-	"github.com/fivetran/go-fivetran/common"
 	"github.com/fivetran/go-fivetran/connections"
 	"github.com/fivetran/go-fivetran/metadata"
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
@@ -63,10 +62,14 @@ func (r *connectionV2) ImportState(ctx context.Context, req resource.ImportState
 	}
 
 	data := model.ConnectionV2ResourceModel{
-		Auth:              types.DynamicNull(),
-		RunSetupTests:     types.BoolValue(false),
-		TrustCertificates: types.BoolValue(false),
-		TrustFingerprints: types.BoolValue(false),
+		Auth:                   types.DynamicNull(),
+		RunSetupTests:          types.BoolValue(false),
+		TrustCertificates:      types.BoolValue(false),
+		TrustFingerprints:      types.BoolValue(false),
+		FailOnSetupTestFailure: types.BoolNull(),
+		RollbackOnFailure:      types.BoolNull(),
+		WaitFor:                types.ObjectNull(model.ConnectionWaitForAttrTypes()),
+		Timeouts:               model.ConnectionCreateTimeoutsNull(),
 	}
 
 	resp.Diagnostics.Append(data.ReadFromResponseForImport(ctx, details, meta)...)
@@ -111,9 +114,18 @@ func (r *connectionV2) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	waitFor, diags := model.GetConnectionWaitFor(ctx, data.WaitFor)
+	resp.Diagnostics.Append(diags...)
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultConnectionCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	runSetupTestsPlan := core.GetBoolOrDefault(data.RunSetupTests, false)
 	trustCertificatesPlan := core.GetBoolOrDefault(data.TrustCertificates, false)
 	trustFingerprintsPlan := core.GetBoolOrDefault(data.TrustFingerprints, false)
+	failOnSetupTestFailurePlan := core.GetBoolOrDefault(data.FailOnSetupTestFailure, r.GetFailOnSetupTestFailure())
 
 	svc := r.GetClient().NewConnectionCreate().
 		Paused(true).
@@ -150,7 +162,22 @@ func (r *connectionV2) Create(ctx context.Context, req resource.CreateRequest, r
 	data.TrustCertificates = types.BoolValue(trustCertificatesPlan)
 	data.TrustFingerprints = types.BoolValue(trustFingerprintsPlan)
 
-	r.warnFailedSetupTests(response.Data.SetupTests, &resp.Diagnostics)
+	reportSetupTests(response.Data.SetupTests, "Connection", failOnSetupTestFailurePlan, &resp.Diagnostics)
+
+	if waitFor.IsSet() && !resp.Diagnostics.HasError() {
+		waitCtx, cancel := context.WithTimeout(ctx, createTimeout)
+		defer cancel()
+		if details := waitForConnection(waitCtx, r.GetClient(), data.Id.ValueString(), waitFor, response.Data.SetupTests, connectionWaitPollInterval, &resp.Diagnostics); details != nil {
+			// status and sync timestamps changed while waiting
+			resp.Diagnostics.Append(data.ReadFromResponse(ctx, *details, meta, configMap)...)
+		}
+	}
+
+	// without rollback the connection is saved to state even if the apply fails, so it's marked as tainted instead of being lost
+	if resp.Diagnostics.HasError() && core.GetBoolOrDefault(data.RollbackOnFailure, false) &&
+		rollbackCreatedConnection(ctx, r.GetClient(), data.Id.ValueString(), &resp.Diagnostics) {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -270,7 +297,7 @@ func (r *connectionV2) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	r.warnFailedSetupTests(response.Data.SetupTests, &resp.Diagnostics)
+	reportSetupTests(response.Data.SetupTests, "Connection", core.GetBoolOrDefault(plan.FailOnSetupTestFailure, r.GetFailOnSetupTestFailure()), &resp.Diagnostics)
 
 	details, err := r.GetClient().NewConnectionDetails().ConnectionID(state.Id.ValueString()).DoCustom(ctx)
 	if err != nil {
@@ -319,17 +346,23 @@ func (r *connectionV2) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *connectionV2) connectorMetadata(ctx context.Context, service string) (*metadata.ConnectorMetadata, error) {
-	cache := r.GetMetadataCache()
+	return providerConnectorMetadata(ctx, &r.ProviderResource, service)
+}
+
+// providerConnectorMetadata returns connector metadata through the provider-level cache.
+// Without a configured client only already cached entries can be returned.
+func providerConnectorMetadata(ctx context.Context, p *core.ProviderResource, service string) (*metadata.ConnectorMetadata, error) {
+	cache := p.GetMetadataCache()
 	if cache == nil {
 		cache = &sync.Map{}
 	}
-	if r.GetClient() == nil {
+	if p.GetClient() == nil {
 		if meta, ok, err := core.LoadCachedConnectorMetadata(cache, service); ok || err != nil {
 			return meta, err
 		}
 		return nil, fmt.Errorf("unconfigured Fivetran client")
 	}
-	return core.GetCachedConnectorMetadata(ctx, r.GetClient(), cache, service)
+	return core.GetCachedConnectorMetadata(ctx, p.GetClient(), cache, service)
 }
 
 func (r *connectionV2) dynamicPlanMaps(ctx context.Context, data model.ConnectionV2ResourceModel, diags *diag.Diagnostics) (map[string]interface{}, map[string]interface{}) {
@@ -413,17 +446,6 @@ func (r *connectionV2) applyUpdateRootFields(svc *connections.ConnectionUpdateSe
 	}
 }
 
-func (r *connectionV2) warnFailedSetupTests(setupTests []common.SetupTestResponse, diags *diag.Diagnostics) {
-	for _, tr := range setupTests {
-		if tr.Status != "PASSED" && tr.Status != "SKIPPED" {
-			diags.AddWarning(
-				fmt.Sprintf("Connection setup test `%v` has status `%v`", tr.Title, tr.Status),
-				tr.Message,
-			)
-		}
-	}
-}
-
 func preserveDynamic(value types.Dynamic) types.Dynamic {
 	if value.IsUnknown() {
 		return types.DynamicNull()
@@ -0,0 +1,337 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithMoveState = &connectionV2{}
+
+// moveStateProviderSuffix matches the fivetran provider address regardless of the registry host,
+// so states from mirrors and dev overrides can be moved too.
+const moveStateProviderSuffix = "fivetran/fivetran"
+
+func (r *connectionV2) MoveState(ctx context.Context) []resource.StateMover {
+	connectorSchema := legacyResourceSchema(ctx, &connector{})
+	connectionSchema := legacyResourceSchema(ctx, &connection{})
+	connectionConfigSchema := fivetranSchema.ConnectionConfigResourceSchema()
+
+	return []resource.StateMover{
+		{
+			SourceSchema: &connectorSchema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if !isMoveFrom(req, "fivetran_connector") {
+					return
+				}
+				moveConnectorState(ctx, req, resp, connectorSchema)
+			},
+		},
+		{
+			SourceSchema: &connectionSchema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if !isMoveFrom(req, "fivetran_connection") {
+					return
+				}
+				moveConnectionState(ctx, req, resp)
+			},
+		},
+		{
+			SourceSchema: &connectionConfigSchema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if !isMoveFrom(req, "fivetran_connection_config") {
+					return
+				}
+				moveConnectionConfigState(ctx, req, resp)
+			},
+		},
+	}
+}
+
+func legacyResourceSchema(ctx context.Context, r resource.Resource) schema.Schema {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+	return resp.Schema
+}
+
+func isMoveFrom(req resource.MoveStateRequest, typeName string) bool {
+	return req.SourceTypeName == typeName && strings.HasSuffix(req.SourceProviderAddress, moveStateProviderSuffix)
+}
+
+func moveConnectorState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse, connectorSchema schema.Schema) {
+	sourceState := req.SourceState
+	if req.SourceSchemaVersion > connectorSchema.Version {
+		addMoveStateVersionError(resp, req)
+		return
+	}
+	// The framework decodes the source state with the current schema regardless of the version, so states of prior
+	// schema versions are brought to the current one with the regular state upgraders even if they happen to decode.
+	// States of the current version written before `destination_schema.table_group_name` existed don't decode.
+	if req.SourceSchemaVersion < connectorSchema.Version || sourceState == nil {
+		if req.SourceRawState == nil {
+			addMoveStateVersionError(resp, req)
+			return
+		}
+		upgraded, diags := upgradeConnectorStateForMove(ctx, req, connectorSchema)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		sourceState = upgraded
+	}
+
+	var source model.ConnectorResourceModel
+	resp.Diagnostics.Append(sourceState.Get(ctx, &source)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configMap, err := source.GetConfigMap(false)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Move Connector Config", err.Error())
+		return
+	}
+	authMap, err := source.GetAuthMap(true)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Move Connector Auth", err.Error())
+		return
+	}
+	foldDestinationSchema(configMap, source.DestinationSchema)
+
+	target := newMovedConnectionV2Model()
+	target.Id = source.Id
+	target.Name = source.Name
+	target.ConnectedBy = source.ConnectedBy
+	target.CreatedAt = source.CreatedAt
+	target.GroupId = source.GroupId
+	target.Service = source.Service
+	target.ProxyAgentId = source.ProxyAgentId
+	target.NetworkingMethod = source.NetworkingMethod
+	target.HybridDeploymentAgentId = source.HybridDeploymentAgentId
+	target.PrivateLinkId = source.PrivateLinkId
+	target.DataDelaySensitivity = source.DataDelaySensitivity
+	target.DataDelayThreshold = source.DataDelayThreshold
+	target.RunSetupTests = source.RunSetupTests
+	target.TrustCertificates = source.TrustCertificates
+	target.TrustFingerprints = source.TrustFingerprints
+
+	setMovedConnectionV2State(ctx, resp, target, configMap, authMap)
+}
+
+func moveConnectionState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if req.SourceState == nil {
+		addMoveStateVersionError(resp, req)
+		return
+	}
+
+	var source model.ConnectionResourceModel
+	resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configMap, diags := jsonStringToMap("config", source.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if configMap == nil {
+		configMap = map[string]interface{}{}
+	}
+	foldDestinationSchema(configMap, source.DestinationSchema)
+
+	target := newMovedConnectionV2Model()
+	target.Id = source.Id
+	target.Name = source.Name
+	target.ConnectedBy = source.ConnectedBy
+	target.CreatedAt = source.CreatedAt
+	target.GroupId = source.GroupId
+	target.Service = source.Service
+	target.ProxyAgentId = source.ProxyAgentId
+	target.NetworkingMethod = source.NetworkingMethod
+	target.HybridDeploymentAgentId = source.HybridDeploymentAgentId
+	target.PrivateLinkId = source.PrivateLinkId
+	target.DataDelaySensitivity = source.DataDelaySensitivity
+	target.DataDelayThreshold = source.DataDelayThreshold
+	target.RunSetupTests = source.RunSetupTests
+	target.TrustCertificates = source.TrustCertificates
+	target.TrustFingerprints = source.TrustFingerprints
+
+	// fivetran_connection never tracked auth, it has to be added to the fivetran_connection_v2 block.
+	setMovedConnectionV2State(ctx, resp, target, configMap, nil)
+}
+
+// moveConnectionConfigState only knows the connection id, config and auth. The remaining
+// connection attributes, including group_id and service, are filled by the refresh that
+// Terraform runs right after the move.
+func moveConnectionConfigState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if req.SourceState == nil {
+		addMoveStateVersionError(resp, req)
+		return
+	}
+
+	var source model.ConnectionConfigModel
+	resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configMap, configDiags := jsonStringToMap("config", source.Config.StringValue)
+	resp.Diagnostics.Append(configDiags...)
+	authMap, authDiags := jsonStringToMap("auth", source.Auth.StringValue)
+	resp.Diagnostics.Append(authDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	target := newMovedConnectionV2Model()
+	target.Id = source.ConnectionId
+	target.RunSetupTests = source.RunSetupTests
+	target.TrustCertificates = source.TrustCertificates
+	target.TrustFingerprints = source.TrustFingerprints
+
+	setMovedConnectionV2State(ctx, resp, target, configMap, authMap)
+}
+
+func upgradeConnectorStateForMove(ctx context.Context, req resource.MoveStateRequest, connectorSchema schema.Schema) (*tfsdk.State, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	upgradeResp := resource.UpgradeStateResponse{}
+	upgradeConnectorState(ctx, resource.UpgradeStateRequest{RawState: req.SourceRawState}, &upgradeResp, int(req.SourceSchemaVersion))
+	diags.Append(upgradeResp.Diagnostics...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if upgradeResp.DynamicValue == nil {
+		diags.AddError(
+			"Unable to Move Prior State",
+			fmt.Sprintf("fivetran_connector state of schema version %v could not be upgraded.", req.SourceSchemaVersion),
+		)
+		return nil, diags
+	}
+
+	raw, err := upgradeResp.DynamicValue.Unmarshal(connectorSchema.Type().TerraformType(ctx))
+	if err != nil {
+		diags.AddError("Unable to Move Prior State", err.Error())
+		return nil, diags
+	}
+
+	return &tfsdk.State{Raw: raw, Schema: connectorSchema}, diags
+}
+
+func addMoveStateVersionError(resp *resource.MoveStateResponse, req resource.MoveStateRequest) {
+	resp.Diagnostics.AddError(
+		"Unable to Move Prior State",
+		fmt.Sprintf("%v state of schema version %v does not match the schema of this provider version. Run `terraform apply -refresh-only` with this provider version before moving the resource to fivetran_connection_v2.",
+			req.SourceTypeName, req.SourceSchemaVersion),
+	)
+}
+
+// newMovedConnectionV2Model returns a model with every attribute null. Computed attributes the
+// source resource does not track are read back from the API on the refresh after the move.
+func newMovedConnectionV2Model() model.ConnectionV2ResourceModel {
+	return model.ConnectionV2ResourceModel{
+		Id:                      types.StringNull(),
+		Name:                    types.StringNull(),
+		ConnectedBy:             types.StringNull(),
+		CreatedAt:               types.StringNull(),
+		GroupId:                 types.StringNull(),
+		Service:                 types.StringNull(),
+		Config:                  types.DynamicNull(),
+		Auth:                    types.DynamicNull(),
+		SucceededAt:             types.StringNull(),
+		FailedAt:                types.StringNull(),
+		ServiceVersion:          types.StringNull(),
+		SyncFrequency:           types.Int64Null(),
+		ScheduleType:            types.StringNull(),
+		PauseAfterTrial:         types.BoolNull(),
+		DailySyncTime:           types.StringNull(),
+		ProxyAgentId:            types.StringNull(),
+		NetworkingMethod:        types.StringNull(),
+		HybridDeploymentAgentId: types.StringNull(),
+		PrivateLinkId:           types.StringNull(),
+		DataDelaySensitivity:    types.StringNull(),
+		DataDelayThreshold:      types.Int64Null(),
+		RunSetupTests:           types.BoolNull(),
+		TrustCertificates:       types.BoolNull(),
+		TrustFingerprints:       types.BoolNull(),
+		FailOnSetupTestFailure:  types.BoolNull(),
+		RollbackOnFailure:       types.BoolNull(),
+		Status:                  types.ObjectNull(model.ConnectionV2StatusAttrTypes()),
+		WaitFor:                 types.ObjectNull(model.ConnectionWaitForAttrTypes()),
+		Timeouts:                model.ConnectionCreateTimeoutsNull(),
+	}
+}
+
+func setMovedConnectionV2State(ctx context.Context, resp *resource.MoveStateResponse, target model.ConnectionV2ResourceModel, configMap, authMap map[string]interface{}) {
+	if len(configMap) > 0 {
+		config, diags := core.MapToDynamic(ctx, configMap)
+		resp.Diagnostics.Append(diags...)
+		target.Config = config
+	}
+	if len(authMap) > 0 {
+		auth, diags := core.MapToDynamic(ctx, authMap)
+		resp.Diagnostics.Append(diags...)
+		target.Auth = auth
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"fivetran_connection_v2 move requires HCL review",
+		"The `config` and `auth` attributes were converted from the prior resource state. Make sure the fivetran_connection_v2 resource block sets the same config and auth fields and review the plan: config fields removed from the block are reset in Fivetran.",
+	)
+
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, &target)...)
+}
+
+// foldDestinationSchema moves the legacy destination_schema block into the config fields the API
+// expects. Values already present in config win.
+func foldDestinationSchema(config map[string]interface{}, destinationSchema types.Object) {
+	if destinationSchema.IsNull() || destinationSchema.IsUnknown() {
+		return
+	}
+
+	fields := map[string]string{
+		"prefix":           "schema_prefix",
+		"name":             "schema",
+		"table":            "table",
+		"table_group_name": "table_group_name",
+	}
+	for attrName, configField := range fields {
+		value, ok := destinationSchema.Attributes()[attrName].(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+			continue
+		}
+		if _, exists := config[configField]; !exists {
+			config[configField] = value.ValueString()
+		}
+	}
+}
+
+func jsonStringToMap(field string, value types.String) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+		return nil, diags
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal([]byte(value.ValueString()), &result); err != nil {
+		diags.AddError(
+			"Unable to Move Prior State",
+			fmt.Sprintf("The `%v` value in the prior state is not a JSON object: %v", field, err),
+		)
+	}
+	return result, diags
+}
@@ -0,0 +1,284 @@
+package resources
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/common"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const testProviderAddress = "registry.terraform.io/fivetran/fivetran"
+
+func TestConnectionV2MoveStateFromConnector(t *testing.T) {
+	common.LoadConfigFieldsMap()
+	common.LoadAuthFieldsMap()
+	ctx := context.Background()
+
+	connectorSchema := legacyResourceSchema(ctx, &connector{})
+	source := nullState(ctx, connectorSchema)
+	setStateAttributes(t, &source, map[string]interface{}{
+		"id":                                  "connection_id",
+		"name":                                "pg",
+		"group_id":                            "group_id",
+		"service":                             "postgres",
+		"proxy_agent_id":                      "proxy_id",
+		"run_setup_tests":                     true,
+		"config.host":                         "db.example.com",
+		"config.port":                         int64(5432),
+		"config.password":                     "secret",
+		"auth.access_token":                   "token",
+		"destination_schema.prefix":           "pg",
+		"destination_schema.name":             nil,
+		"destination_schema.table":            nil,
+		"destination_schema.table_group_name": nil,
+	})
+
+	resp := moveState(t, "fivetran_connector", connectorSchema.Version, &source, nil)
+	assertWarningCount(t, resp.Diagnostics, 1)
+
+	target := movedModel(t, resp)
+	if target.Id.ValueString() != "connection_id" || target.GroupId.ValueString() != "group_id" || target.Service.ValueString() != "postgres" {
+		t.Fatalf("unexpected root fields: id=%v group_id=%v service=%v", target.Id, target.GroupId, target.Service)
+	}
+	if target.ProxyAgentId.ValueString() != "proxy_id" || !target.RunSetupTests.ValueBool() {
+		t.Fatalf("unexpected optional fields: proxy_agent_id=%v run_setup_tests=%v", target.ProxyAgentId, target.RunSetupTests)
+	}
+
+	config := dynamicMap(t, target.Config)
+	want := map[string]interface{}{
+		"host":          "db.example.com",
+		"port":          int64(5432),
+		"password":      "secret",
+		"schema_prefix": "pg",
+	}
+	assertMapEquals(t, "config", config, want)
+	assertMapEquals(t, "auth", dynamicMap(t, target.Auth), map[string]interface{}{"access_token": "token"})
+}
+
+func TestConnectionV2MoveStateFromPriorConnectorSchemaVersion(t *testing.T) {
+	common.LoadConfigFieldsMap()
+	common.LoadAuthFieldsMap()
+
+	// Schema version 4 states were written before destination_schema.table_group_name existed.
+	rawState := tfprotov6.RawState{JSON: []byte(`{
+		"id": "connection_id",
+		"group_id": "group_id",
+		"service": "postgres",
+		"run_setup_tests": true,
+		"config": {"host": "db.example.com"},
+		"destination_schema": {"name": null, "table": null, "prefix": "pg"}
+	}`)}
+
+	resp := moveState(t, "fivetran_connector", 4, nil, &rawState)
+	assertErrorCount(t, resp.Diagnostics, 0)
+
+	target := movedModel(t, resp)
+	assertMapEquals(t, "config", dynamicMap(t, target.Config), map[string]interface{}{
+		"host":          "db.example.com",
+		"schema_prefix": "pg",
+	})
+	if !target.Auth.IsNull() {
+		t.Fatalf("auth = %v, want null", target.Auth)
+	}
+}
+
+func TestConnectionV2MoveStateUpgradesDecodablePriorConnectorState(t *testing.T) {
+	common.LoadConfigFieldsMap()
+	common.LoadAuthFieldsMap()
+	ctx := context.Background()
+
+	// The prior state decodes with the current schema as well, the state upgraders still have to be applied to the raw state.
+	connectorSchema := legacyResourceSchema(ctx, &connector{})
+	source := nullState(ctx, connectorSchema)
+	setStateAttributes(t, &source, map[string]interface{}{
+		"id":                                  "connection_id",
+		"group_id":                            "group_id",
+		"service":                             "postgres",
+		"config.host":                         "decoded.example.com",
+		"destination_schema.prefix":           "decoded",
+		"destination_schema.name":             nil,
+		"destination_schema.table":            nil,
+		"destination_schema.table_group_name": nil,
+	})
+	rawState := tfprotov6.RawState{JSON: []byte(`{
+		"id": "connection_id",
+		"group_id": "group_id",
+		"service": "postgres",
+		"config": {"host": "db.example.com"},
+		"destination_schema": {"name": null, "table": null, "prefix": "pg"}
+	}`)}
+
+	resp := moveState(t, "fivetran_connector", 3, &source, &rawState)
+
+	assertMapEquals(t, "config", dynamicMap(t, movedModel(t, resp).Config), map[string]interface{}{
+		"host":          "db.example.com",
+		"schema_prefix": "pg",
+	})
+}
+
+func TestConnectionV2MoveStateFromConnection(t *testing.T) {
+	ctx := context.Background()
+
+	connectionSchema := legacyResourceSchema(ctx, &connection{})
+	source := nullState(ctx, connectionSchema)
+	setStateAttributes(t, &source, map[string]interface{}{
+		"id":                                  "connection_id",
+		"group_id":                            "group_id",
+		"service":                             "s3",
+		"config":                              `{"bucket": "logs", "file_type": "csv"}`,
+		"destination_schema.name":             "s3",
+		"destination_schema.table":            "events",
+		"destination_schema.prefix":           nil,
+		"destination_schema.table_group_name": nil,
+	})
+
+	resp := moveState(t, "fivetran_connection", connectionSchema.Version, &source, nil)
+	assertErrorCount(t, resp.Diagnostics, 0)
+
+	target := movedModel(t, resp)
+	assertMapEquals(t, "config", dynamicMap(t, target.Config), map[string]interface{}{
+		"bucket":    "logs",
+		"file_type": "csv",
+		"schema":    "s3",
+		"table":     "events",
+	})
+	if !target.Auth.IsNull() {
+		t.Fatalf("auth = %v, want null", target.Auth)
+	}
+}
+
+func TestConnectionV2MoveStateFromConnectionConfig(t *testing.T) {
+	ctx := context.Background()
+
+	connectionConfigSchema := fivetranSchema.ConnectionConfigResourceSchema()
+	source := nullState(ctx, connectionConfigSchema)
+	setStateAttributes(t, &source, map[string]interface{}{
+		"id":                 "connection_id",
+		"connection_id":      "connection_id",
+		"config":             `{"host": "db.example.com", "port": 5432}`,
+		"auth":               `{"client_access": {"client_id": "id", "client_secret": "secret"}}`,
+		"run_setup_tests":    false,
+		"trust_certificates": true,
+		"trust_fingerprints": false,
+	})
+
+	resp := moveState(t, "fivetran_connection_config", 0, &source, nil)
+	assertErrorCount(t, resp.Diagnostics, 0)
+
+	target := movedModel(t, resp)
+	if target.Id.ValueString() != "connection_id" || !target.GroupId.IsNull() || !target.TrustCertificates.ValueBool() {
+		t.Fatalf("unexpected root fields: id=%v group_id=%v trust_certificates=%v", target.Id, target.GroupId, target.TrustCertificates)
+	}
+	assertMapEquals(t, "config", dynamicMap(t, target.Config), map[string]interface{}{
+		"host": "db.example.com",
+		"port": int64(5432),
+	})
+	assertMapEquals(t, "auth", dynamicMap(t, target.Auth), map[string]interface{}{
+		"client_access": map[string]interface{}{"client_id": "id", "client_secret": "secret"},
+	})
+}
+
+func TestConnectionV2MoveStateSkipsOtherSources(t *testing.T) {
+	ctx := context.Background()
+
+	connectionConfigSchema := fivetranSchema.ConnectionConfigResourceSchema()
+	source := nullState(ctx, connectionConfigSchema)
+
+	resp := moveState(t, "fivetran_destination", 0, &source, nil)
+	assertNoDiagnostics(t, resp.Diagnostics)
+	if !resp.TargetState.Raw.IsNull() {
+		t.Fatal("unsupported sources must leave the target state untouched")
+	}
+}
+
+func moveState(t *testing.T, sourceType string, version int64, source *tfsdk.State, rawState *tfprotov6.RawState) resource.MoveStateResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	targetSchema := fivetranSchema.ConnectionV2ResourceSchema()
+	resp := resource.MoveStateResponse{TargetState: nullState(ctx, targetSchema)}
+
+	r := &connectionV2{}
+	for _, mover := range r.MoveState(ctx) {
+		req := resource.MoveStateRequest{
+			SourceProviderAddress: testProviderAddress,
+			SourceTypeName:        sourceType,
+			SourceSchemaVersion:   version,
+			SourceRawState:        rawState,
+		}
+		// The framework only populates the source state when it matches the mover schema.
+		if source != nil && source.Schema.Type().TerraformType(ctx).Equal(mover.SourceSchema.Type().TerraformType(ctx)) {
+			req.SourceState = source
+		}
+		mover.StateMover(ctx, req, &resp)
+		if resp.Diagnostics.HasError() || !resp.TargetState.Raw.IsNull() {
+			break
+		}
+	}
+	assertErrorCount(t, resp.Diagnostics, 0)
+	return resp
+}
+
+func movedModel(t *testing.T, resp resource.MoveStateResponse) model.ConnectionV2ResourceModel {
+	t.Helper()
+	var target model.ConnectionV2ResourceModel
+	diags := resp.TargetState.Get(context.Background(), &target)
+	if diags.HasError() {
+		t.Fatalf("target state diagnostics: %v", diags)
+	}
+	return target
+}
+
+func nullState(ctx context.Context, s schema.Schema) tfsdk.State {
+	return tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+}
+
+// setStateAttributes sets attributes addressed as "attr" or "block.attr". A nil value sets a null string.
+func setStateAttributes(t *testing.T, state *tfsdk.State, values map[string]interface{}) {
+	t.Helper()
+	ctx := context.Background()
+
+	for name, value := range values {
+		p := path.Root(name)
+		if root, attr, ok := strings.Cut(name, "."); ok {
+			p = path.Root(root).AtName(attr)
+		}
+		if value == nil {
+			value = (*string)(nil)
+		}
+		if diags := state.SetAttribute(ctx, p, value); diags.HasError() {
+			t.Fatalf("setting %s: %v", name, diags)
+		}
+	}
+}
+
+func dynamicMap(t *testing.T, value types.Dynamic) map[string]interface{} {
+	t.Helper()
+	if value.IsNull() {
+		t.Fatal("dynamic value is null")
+	}
+	result, diags := core.DynamicToMap(context.Background(), value)
+	if diags.HasError() {
+		t.Fatalf("DynamicToMap diagnostics: %v", diags)
+	}
+	return result
+}
+
+func assertMapEquals(t *testing.T, name string, got, want map[string]interface{}) {
+	t.Helper()
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("%s = %#v, want %#v", name, got, want)
+	}
+}
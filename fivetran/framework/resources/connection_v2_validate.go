@@ -48,11 +48,11 @@ func (r *connectionV2) ValidateConfig(ctx context.Context, req resource.Validate
 		return
 	}
 
-	validateDynamicObject(configMap, &meta.Config, path.Root("config"), &resp.Diagnostics)
-	validateDynamicObject(authMap, &meta.Auth, path.Root("auth"), &resp.Diagnostics)
+	validateDynamicObject(configMap, &meta.Config, path.Root("config"), r.GetFieldStatusPolicy(), &resp.Diagnostics)
+	validateDynamicObject(authMap, &meta.Auth, path.Root("auth"), r.GetFieldStatusPolicy(), &resp.Diagnostics)
 }
 
-func validateDynamicObject(values map[string]interface{}, slot *metadata.Property, root path.Path, diags *diag.Diagnostics) {
+func validateDynamicObject(values map[string]interface{}, slot *metadata.Property, root path.Path, fieldStatusPolicy string, diags *diag.Diagnostics) {
 	if values == nil {
 		return
 	}
@@ -77,25 +77,46 @@ func validateDynamicObject(values map[string]interface{}, slot *metadata.Propert
 			continue
 		}
 
-		if !core.IsKnownMetadataFieldStatus(prop.FieldStatus) {
-			diags.AddAttributeWarning(
-				fieldPath,
-				"Unknown Metadata Field Status",
-				fmt.Sprintf("Field %q has unknown metadata fieldStatus %q. Terraform will validate the field shape, but the field availability may need provider support in the future.", name, prop.FieldStatus),
-			)
-		} else if core.ShouldWarnForMetadataFieldStatus(prop) {
-			diags.AddAttributeWarning(
-				fieldPath,
-				"Non-Standard Dynamic Field",
-				fmt.Sprintf("Field %q is marked as %q in connector metadata. It is accepted because the metadata endpoint returned it for this account, but its availability or behavior may change, including potential removal for sunset fields.", name, prop.FieldStatus),
-			)
-		}
+		reportMetadataFieldStatus(name, prop, fieldPath, fieldStatusPolicy, diags)
 
-		validateDynamicValue(value, prop, fieldPath, diags)
+		validateDynamicValue(value, prop, fieldPath, fieldStatusPolicy, diags)
 	}
 }
 
-func validateDynamicValue(value interface{}, prop *metadata.Property, valuePath path.Path, diags *diag.Diagnostics) {
+// reportMetadataFieldStatus applies the provider `field_status_policy` to a configured field.
+func reportMetadataFieldStatus(name string, prop *metadata.Property, fieldPath path.Path, fieldStatusPolicy string, diags *diag.Diagnostics) {
+	if fieldStatusPolicy == core.FieldStatusPolicyIgnore {
+		return
+	}
+
+	if !core.IsKnownMetadataFieldStatus(prop.FieldStatus) {
+		diags.AddAttributeWarning(
+			fieldPath,
+			"Unknown Metadata Field Status",
+			fmt.Sprintf("Field %q has unknown metadata fieldStatus %q. Terraform will validate the field shape, but the field availability may need provider support in the future.", name, prop.FieldStatus),
+		)
+		return
+	}
+	if !core.ShouldWarnForMetadataFieldStatus(prop) {
+		return
+	}
+
+	if fieldStatusPolicy == core.FieldStatusPolicyError {
+		diags.AddAttributeError(
+			fieldPath,
+			"Non-Standard Dynamic Field",
+			fmt.Sprintf("Field %q is marked as %q in connector metadata. The provider `field_status_policy` is %q, so only generally available fields are accepted.", name, prop.FieldStatus, fieldStatusPolicy),
+		)
+		return
+	}
+	diags.AddAttributeWarning(
+		fieldPath,
+		"Non-Standard Dynamic Field",
+		fmt.Sprintf("Field %q is marked as %q in connector metadata. It is accepted because the metadata endpoint returned it for this account, but its availability or behavior may change, including potential removal for sunset fields.", name, prop.FieldStatus),
+	)
+}
+
+func validateDynamicValue(value interface{}, prop *metadata.Property, valuePath path.Path, fieldStatusPolicy string, diags *diag.Diagnostics) {
 	if prop == nil {
 		return
 	}
@@ -139,7 +160,7 @@ func validateDynamicValue(value interface{}, prop *metadata.Property, valuePath
 			return
 		}
 		for i, item := range items {
-			validateDynamicValue(item, prop.Items, valuePath.AtListIndex(i), diags)
+			validateDynamicValue(item, prop.Items, valuePath.AtListIndex(i), fieldStatusPolicy, diags)
 		}
 	case "object":
 		nested, ok := value.(map[string]interface{})
@@ -147,7 +168,7 @@ func validateDynamicValue(value interface{}, prop *metadata.Property, valuePath
 			addTypeError(valuePath, prop.Type, value, diags)
 			return
 		}
-		validateDynamicObject(nested, prop, valuePath, diags)
+		validateDynamicObject(nested, prop, valuePath, fieldStatusPolicy, diags)
 	case "":
 		return
 	default:
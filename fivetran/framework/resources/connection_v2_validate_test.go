@@ -58,7 +58,7 @@ func TestValidateDynamicObjectAcceptsKnownFields(t *testing.T) {
 				},
 			},
 		},
-	}, path.Root("config"), &diags)
+	}, path.Root("config"), core.FieldStatusPolicyWarn, &diags)
 
 	if diags.HasError() {
 		t.Fatalf("unexpected validation errors: %v", diags)
@@ -73,6 +73,7 @@ func TestValidateDynamicObjectRejectsUnknownField(t *testing.T) {
 		map[string]interface{}{"dog": "good_boy"},
 		&metadata.Property{Properties: map[string]*metadata.Property{"schema": {Type: "string"}}},
 		path.Root("config"),
+		core.FieldStatusPolicyWarn,
 		&diags,
 	)
 
@@ -89,6 +90,7 @@ func TestValidateDynamicObjectRejectsWrongType(t *testing.T) {
 		map[string]interface{}{"schema": true},
 		&metadata.Property{Properties: map[string]*metadata.Property{"schema": {Type: "string"}}},
 		path.Root("config"),
+		core.FieldStatusPolicyWarn,
 		&diags,
 	)
 
@@ -107,6 +109,7 @@ func TestValidateDynamicObjectRejectsInvalidEnum(t *testing.T) {
 			"sync_mode": {Type: "string", Enum: []string{"AllAccounts", "SpecificAccounts"}},
 		}},
 		path.Root("config"),
+		core.FieldStatusPolicyWarn,
 		&diags,
 	)
 
@@ -155,7 +158,7 @@ func TestValidateDynamicValueTypes(t *testing.T) {
 			t.Parallel()
 
 			var diags diag.Diagnostics
-			validateDynamicValue(tt.value, tt.prop, path.Root("config").AtName("field"), &diags)
+			validateDynamicValue(tt.value, tt.prop, path.Root("config").AtName("field"), core.FieldStatusPolicyWarn, &diags)
 			assertErrorCount(t, diags, tt.wantErrors)
 			assertWarningCount(t, diags, 0)
 		})
@@ -226,7 +229,7 @@ func TestValidateDynamicValueCollections(t *testing.T) {
 			t.Parallel()
 
 			var diags diag.Diagnostics
-			validateDynamicValue(tt.value, tt.prop, path.Root("config").AtName("field"), &diags)
+			validateDynamicValue(tt.value, tt.prop, path.Root("config").AtName("field"), core.FieldStatusPolicyWarn, &diags)
 			assertErrorCount(t, diags, tt.wantErrors)
 			assertWarningCount(t, diags, 0)
 		})
@@ -243,6 +246,7 @@ func TestValidateDynamicObjectRejectsUnknownMetadataType(t *testing.T) {
 			"schema": {Type: "wat"},
 		}},
 		path.Root("config"),
+		core.FieldStatusPolicyWarn,
 		&diags,
 	)
 
@@ -279,6 +283,7 @@ func TestValidateDynamicObjectFieldStatuses(t *testing.T) {
 					"field": {Type: "string", FieldStatus: tt.fieldStatus},
 				}},
 				path.Root("config"),
+				core.FieldStatusPolicyWarn,
 				&diags,
 			)
 
@@ -298,6 +303,7 @@ func TestValidateDynamicObjectWarnsForNonGAFieldStatus(t *testing.T) {
 			"preview_field": {Type: "string", FieldStatus: "private_preview"},
 		}},
 		path.Root("config"),
+		core.FieldStatusPolicyWarn,
 		&diags,
 	)
 
@@ -309,6 +315,55 @@ func TestValidateDynamicObjectWarnsForNonGAFieldStatus(t *testing.T) {
 	}
 }
 
+func TestValidateDynamicObjectFieldStatusPolicy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		policy       string
+		fieldStatus  string
+		wantErrors   int
+		wantWarnings int
+	}{
+		{name: "warn on sunset", policy: core.FieldStatusPolicyWarn, fieldStatus: core.FieldStatusSunset, wantWarnings: 1},
+		{name: "error on sunset", policy: core.FieldStatusPolicyError, fieldStatus: core.FieldStatusSunset, wantErrors: 1},
+		{name: "error on private preview", policy: core.FieldStatusPolicyError, fieldStatus: core.FieldStatusPrivatePreview, wantErrors: 1},
+		{name: "error accepts general availability", policy: core.FieldStatusPolicyError, fieldStatus: core.FieldStatusGeneralAvailability},
+		{name: "error keeps unknown status as warning", policy: core.FieldStatusPolicyError, fieldStatus: "some_future_status", wantWarnings: 1},
+		{name: "ignore development", policy: core.FieldStatusPolicyIgnore, fieldStatus: core.FieldStatusDevelopment},
+		{name: "ignore unknown status", policy: core.FieldStatusPolicyIgnore, fieldStatus: "some_future_status"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+			validateDynamicObject(
+				map[string]interface{}{"nested": map[string]interface{}{"field": "value"}},
+				&metadata.Property{Properties: map[string]*metadata.Property{
+					"nested": {Type: "object", Properties: map[string]*metadata.Property{
+						"field": {Type: "string", FieldStatus: tt.fieldStatus},
+					}},
+				}},
+				path.Root("config"),
+				tt.policy,
+				&diags,
+			)
+
+			assertErrorCount(t, diags, tt.wantErrors)
+			assertWarningCount(t, diags, tt.wantWarnings)
+			for _, d := range diags {
+				withPath, ok := d.(diag.DiagnosticWithPath)
+				if !ok || !withPath.Path().Equal(path.Root("config").AtName("nested").AtName("field")) {
+					t.Fatalf("diagnostic %v does not point to config.nested.field", d)
+				}
+			}
+		})
+	}
+}
+
 func TestConnectionV2ValidateConfigEarlyReturns(t *testing.T) {
 	t.Parallel()
 
@@ -627,7 +682,11 @@ func connectionV2ValidateConfigRequestWithDynamic(t *testing.T, service types.St
 		RunSetupTests:           types.BoolNull(),
 		TrustCertificates:       types.BoolNull(),
 		TrustFingerprints:       types.BoolNull(),
+		FailOnSetupTestFailure:  types.BoolNull(),
+		RollbackOnFailure:       types.BoolNull(),
 		Status:                  types.ObjectNull(model.ConnectionV2StatusAttrTypes()),
+		WaitFor:                 types.ObjectNull(model.ConnectionWaitForAttrTypes()),
+		Timeouts:                model.ConnectionCreateTimeoutsNull(),
 	}
 
 	var object types.Object
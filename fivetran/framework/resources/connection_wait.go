@@ -0,0 +1,105 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/common"
+	"github.com/fivetran/go-fivetran/connections"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+const (
+	defaultConnectionCreateTimeout = 30 * time.Minute
+	connectionWaitPollInterval     = 15 * time.Second
+)
+
+// waitForConnection polls the details of the created connection until the `wait_for` conditions are met and returns the last details read,
+// or nil if the wait failed. The connection is unpaused once it is connected, if the setup state is awaited. The wait fails right away
+// if the connection setup is broken or the initial sync fails, the failed setup tests of the create response and the connection tasks are reported.
+func waitForConnection(ctx context.Context, client *fivetran.Client, connectionId string, waitFor model.ConnectionWaitFor,
+	setupTests []common.SetupTestResponse, pollInterval time.Duration, diags *diag.Diagnostics) *connections.DetailsWithCustomConfigNoTestsResponse {
+	unpaused := false
+	pending := "connection details were not read"
+	var status connections.StatusResponse
+	for {
+		details, err := client.NewConnectionDetails().ConnectionID(connectionId).DoCustom(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				addConnectionWaitTimeout(connectionId, pending, setupTests, status, diags)
+				return nil
+			}
+			diags.AddError(
+				"Unable to Wait for Connection.",
+				fmt.Sprintf("Error while reading connection %v. %v; code: %v; message: %v", connectionId, err, details.Code, details.Message),
+			)
+			return nil
+		}
+
+		status = details.Data.Status
+		if status.SetupState == "broken" {
+			diags.AddError(
+				"Connection Setup Is Broken.",
+				connectionWaitErrorDetails(fmt.Sprintf("The setup state of connection %v is `broken`.", connectionId), setupTests, status),
+			)
+			return nil
+		}
+
+		if waitFor.SetupStateConnected && status.SetupState != "connected" {
+			pending = fmt.Sprintf("the setup state is `%v`", status.SetupState)
+		} else {
+			if waitFor.Unpause && !unpaused {
+				if response, err := client.NewConnectionUpdate().ConnectionID(connectionId).Paused(false).DoCustom(ctx); err != nil {
+					diags.AddError(
+						"Unable to Unpause Connection.",
+						fmt.Sprintf("%v; code: %v; message: %v", err, response.Code, response.Message),
+					)
+					return nil
+				}
+				unpaused = true
+			}
+			if !waitFor.InitialSyncComplete || !details.Data.SucceededAt.IsZero() {
+				return &details
+			}
+			if !details.Data.FailedAt.IsZero() {
+				diags.AddError(
+					"Connection Initial Sync Failed.",
+					connectionWaitErrorDetails(fmt.Sprintf("The initial sync of connection %v failed at %v.", connectionId, details.Data.FailedAt), setupTests, status),
+				)
+				return nil
+			}
+			pending = fmt.Sprintf("the initial sync is not complete, the sync state is `%v`", status.SyncState)
+		}
+
+		select {
+		case <-ctx.Done():
+			addConnectionWaitTimeout(connectionId, pending, setupTests, status, diags)
+			return nil
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+func addConnectionWaitTimeout(connectionId, pending string, setupTests []common.SetupTestResponse, status connections.StatusResponse, diags *diag.Diagnostics) {
+	diags.AddError(
+		"Connection Wait Timed Out.",
+		connectionWaitErrorDetails(fmt.Sprintf("The wait for connection %v timed out, %v. Increase the `create` timeout to wait longer.", connectionId, pending), setupTests, status),
+	)
+}
+
+func connectionWaitErrorDetails(message string, setupTests []common.SetupTestResponse, status connections.StatusResponse) string {
+	lines := []string{message}
+	for _, tr := range setupTests {
+		if tr.Status != "PASSED" && tr.Status != "SKIPPED" {
+			lines = append(lines, fmt.Sprintf("Setup test `%v` has status `%v`: %v", tr.Title, tr.Status, tr.Message))
+		}
+	}
+	for _, task := range status.Tasks {
+		lines = append(lines, fmt.Sprintf("Task `%v`: %v", task.Code, task.Message))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,115 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/common"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// connectionDetailsSequenceHTTPClient returns the connection details one by one, the last ones are repeated, and records patches.
+type connectionDetailsSequenceHTTPClient struct {
+	details []string
+	mutex   sync.Mutex
+	reads   int
+	patches []map[string]interface{}
+}
+
+func (c *connectionDetailsSequenceHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if req.Method == http.MethodPatch {
+		body, _ := io.ReadAll(req.Body)
+		patch := map[string]interface{}{}
+		_ = json.Unmarshal(body, &patch)
+		c.patches = append(c.patches, patch)
+		return staticHTTPClient{body: `{"code":"Success","data":{"id":"connection_id"}}`}.Do(req)
+	}
+	details := c.details[min(c.reads, len(c.details)-1)]
+	c.reads++
+	return staticHTTPClient{body: details}.Do(req)
+}
+
+func connectionDetails(setupState, syncState string, paused bool, succeededAt string) string {
+	succeeded := ""
+	if succeededAt != "" {
+		succeeded = fmt.Sprintf(`"succeeded_at":%q,`, succeededAt)
+	}
+	return fmt.Sprintf(`{"code":"Success","data":{"id":"connection_id","paused":%v,%v
+		"status":{"setup_state":%q,"sync_state":%q,"tasks":[{"code":"resync_table_warning","message":"Table resync required"}]}}}`,
+		paused, succeeded, setupState, syncState)
+}
+
+func waitForTestConnection(httpClient *connectionDetailsSequenceHTTPClient, waitFor model.ConnectionWaitFor, timeout time.Duration, setupTests []common.SetupTestResponse) (bool, diag.Diagnostics) {
+	client := fivetran.New("key", "secret")
+	client.SetHttpClient(httpClient)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var diags diag.Diagnostics
+	details := waitForConnection(ctx, client, "connection_id", waitFor, setupTests, time.Millisecond, &diags)
+	return details != nil, diags
+}
+
+func TestWaitForConnectionUnpausesConnectedConnection(t *testing.T) {
+	t.Parallel()
+
+	httpClient := &connectionDetailsSequenceHTTPClient{details: []string{
+		connectionDetails("incomplete", "paused", true, ""),
+		connectionDetails("connected", "paused", true, ""),
+		connectionDetails("connected", "syncing", false, ""),
+		connectionDetails("connected", "scheduled", false, "2026-10-19T10:00:00Z"),
+	}}
+	waitFor := model.ConnectionWaitFor{SetupStateConnected: true, InitialSyncComplete: true, Unpause: true}
+	completed, diags := waitForTestConnection(httpClient, waitFor, time.Minute, nil)
+	assertNoDiagnostics(t, diags)
+
+	if !completed || httpClient.reads != 4 {
+		t.Errorf("completed = %v, reads = %v, want completed after 4 reads", completed, httpClient.reads)
+	}
+	if len(httpClient.patches) != 1 || httpClient.patches[0]["paused"] != false {
+		t.Errorf("connection should be unpaused once, patches: %v", httpClient.patches)
+	}
+}
+
+func TestWaitForConnectionFailsOnBrokenSetup(t *testing.T) {
+	t.Parallel()
+
+	httpClient := &connectionDetailsSequenceHTTPClient{details: []string{connectionDetails("broken", "paused", true, "")}}
+	setupTests := []common.SetupTestResponse{
+		{Title: "Connecting to host", Status: "FAILED", Message: "Connection refused"},
+		{Title: "Validating certificate", Status: "PASSED"},
+	}
+	completed, diags := waitForTestConnection(httpClient, model.ConnectionWaitFor{SetupStateConnected: true, Unpause: true}, time.Minute, setupTests)
+
+	assertErrorCount(t, diags, 1)
+	if completed || len(httpClient.patches) != 0 {
+		t.Errorf("broken connection should not be unpaused, patches: %v", httpClient.patches)
+	}
+	detail := diags.Errors()[0].Detail()
+	if !strings.Contains(detail, "Connecting to host") || strings.Contains(detail, "Validating certificate") || !strings.Contains(detail, "Table resync required") {
+		t.Errorf("error should list failed setup tests and tasks: %v", detail)
+	}
+}
+
+func TestWaitForConnectionTimesOut(t *testing.T) {
+	t.Parallel()
+
+	httpClient := &connectionDetailsSequenceHTTPClient{details: []string{connectionDetails("incomplete", "paused", true, "")}}
+	completed, diags := waitForTestConnection(httpClient, model.ConnectionWaitFor{SetupStateConnected: true}, 20*time.Millisecond, nil)
+
+	assertErrorCount(t, diags, 1)
+	if completed || diags.Errors()[0].Summary() != "Connection Wait Timed Out." || !strings.Contains(diags.Errors()[0].Detail(), "`incomplete`") {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+}
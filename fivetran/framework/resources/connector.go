@@ -8,7 +8,7 @@ import (
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
 	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -27,6 +27,7 @@ type connector struct {
 var _ resource.ResourceWithConfigure = &connector{}
 var _ resource.ResourceWithUpgradeState = &connector{}
 var _ resource.ResourceWithImportState = &connector{}
+var _ resource.ResourceWithIdentity = &connector{}
 
 func (r *connector) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_connector"
@@ -40,8 +41,13 @@ func (r *connector) Schema(ctx context.Context, req resource.SchemaRequest, resp
 	}
 }
 
+func (r *connector) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = fivetranSchema.ConnectionIdentitySchema()
+}
+
 func (r *connector) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id, err := resolveConnectionImport(ctx, r.GetClient(), req)
+	importResolvedId(ctx, resp, "Unable to Import Connector Resource.", id, err, "id")
 }
 
 func (r *connector) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
@@ -143,9 +149,38 @@ func (r *connector) Create(ctx context.Context, req resource.CreateRequest, resp
 		configMap[k] = v
 	}
 
+	waitFor, diags := model.GetConnectionWaitFor(ctx, data.WaitFor)
+	resp.Diagnostics.Append(diags...)
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultConnectionCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	runSetupTestsPlan := core.GetBoolOrDefault(data.RunSetupTests, false)
 	trustCertificatesPlan := core.GetBoolOrDefault(data.TrustCertificates, false)
 	trustFingerprintsPlan := core.GetBoolOrDefault(data.TrustFingerprints, false)
+	failOnSetupTestFailurePlan := core.GetBoolOrDefault(data.FailOnSetupTestFailure, r.GetFailOnSetupTestFailure())
+	rollbackOnFailurePlan := core.GetBoolOrDefault(data.RollbackOnFailure, false)
+	adoptExistingPlan := core.GetBoolOrDefault(data.AdoptExisting, false)
+
+	if adoptExistingPlan {
+		existingId, err := findConnectionIdBySchema(ctx, r.GetClient(), data.GroupId.ValueString(), connectorSchemaName(destinationSchema))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Create Connector Resource.",
+				fmt.Sprintf("Error while looking up the connector to adopt. %v", err),
+			)
+			return
+		}
+		if existingId != "" {
+			if r.adopt(ctx, &data, existingId, &resp.Diagnostics) {
+				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, connectionIdentity(data.Id, data.GroupId, data.Name))...)
+			}
+			return
+		}
+	}
 
 	svc := r.GetClient().NewConnectionCreate().
 		Paused(true). // on creation we always create paused connector
@@ -202,19 +237,27 @@ func (r *connector) Create(ctx context.Context, req resource.CreateRequest, resp
 	data.RunSetupTests = types.BoolValue(runSetupTestsPlan)
 	data.TrustCertificates = types.BoolValue(trustCertificatesPlan)
 	data.TrustFingerprints = types.BoolValue(trustFingerprintsPlan)
+	data.FailOnSetupTestFailure = types.BoolValue(failOnSetupTestFailurePlan)
+	data.RollbackOnFailure = types.BoolValue(rollbackOnFailurePlan)
+	data.AdoptExisting = types.BoolValue(adoptExistingPlan)
 
-	if runSetupTestsPlan && response.Data.SetupTests != nil && len(response.Data.SetupTests) > 0 {
-		for _, tr := range response.Data.SetupTests {
-			if tr.Status != "PASSED" && tr.Status != "SKIPPED" {
-				resp.Diagnostics.AddWarning(
-					fmt.Sprintf("Connector setup test `%v` has status `%v`", tr.Title, tr.Status),
-					tr.Message,
-				)
-			}
-		}
+	if runSetupTestsPlan {
+		reportSetupTests(response.Data.SetupTests, "Connector", failOnSetupTestFailurePlan, &resp.Diagnostics)
+	}
+
+	if waitFor.IsSet() && !resp.Diagnostics.HasError() {
+		waitCtx, cancel := context.WithTimeout(ctx, createTimeout)
+		defer cancel()
+		waitForConnection(waitCtx, r.GetClient(), data.Id.ValueString(), waitFor, response.Data.SetupTests, connectionWaitPollInterval, &resp.Diagnostics)
+	}
+
+	// without rollback the connector is saved to state even if the apply fails, so it's marked as tainted instead of being lost
+	if resp.Diagnostics.HasError() && rollbackOnFailurePlan && rollbackCreatedConnection(ctx, r.GetClient(), data.Id.ValueString(), &resp.Diagnostics) {
+		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, connectionIdentity(data.Id, data.GroupId, data.Name))...)
 }
 
 func (r *connector) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -289,6 +332,7 @@ func (r *connector) Read(ctx context.Context, req resource.ReadRequest, resp *re
 	data.TrustFingerprints = trustFingerprints
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, connectionIdentity(data.Id, data.GroupId, data.Name))...)
 }
 
 func (r *connector) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -306,9 +350,22 @@ func (r *connector) Update(ctx context.Context, req resource.UpdateRequest, resp
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	if !r.applyUpdate(ctx, &plan, state, &resp.Diagnostics) {
+		return
+	}
+
+	// Save state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, connectionIdentity(plan.Id, plan.GroupId, plan.Name))...)
+}
+
+// applyUpdate patches the connector in state to the plan and reads the result into the plan.
+// Returns false if the connector couldn't be updated, the state has to be kept as is then.
+func (r *connector) applyUpdate(ctx context.Context, plan *model.ConnectorResourceModel, state model.ConnectorResourceModel, diags *diag.Diagnostics) bool {
 	runSetupTestsPlan := core.GetBoolOrDefault(plan.RunSetupTests, false)
 	trustCertificatesPlan := core.GetBoolOrDefault(plan.TrustCertificates, false)
 	trustFingerprintsPlan := core.GetBoolOrDefault(plan.TrustFingerprints, false)
+	failOnSetupTestFailurePlan := core.GetBoolOrDefault(plan.FailOnSetupTestFailure, r.GetFailOnSetupTestFailure())
 
 	runSetupTestsState := core.GetBoolOrDefault(state.RunSetupTests, false)
 	trustCertificatesState := core.GetBoolOrDefault(state.TrustCertificates, false)
@@ -319,9 +376,9 @@ func (r *connector) Update(ctx context.Context, req resource.UpdateRequest, resp
 		(trustCertificatesPlan && trustCertificatesPlan != trustCertificatesState) ||
 		(trustFingerprintsPlan && trustFingerprintsPlan != trustFingerprintsState)
 
-	hasUpdates, patch, authPatch, err := plan.HasUpdates(plan, state)
+	hasUpdates, patch, authPatch, err := plan.HasUpdates(*plan, state)
     if err != nil {
-        resp.Diagnostics.AddError(
+        diags.AddError(
             "Unable to Update Connector Resource.",
             fmt.Sprintf("%v; ", err),
         )
@@ -370,11 +427,11 @@ func (r *connector) Update(ctx context.Context, req resource.UpdateRequest, resp
 		response, err := svc.DoCustom(ctx)
 
 		if err != nil {
-			resp.Diagnostics.AddError(
+			diags.AddError(
 				"Unable to Update Connector Resource.",
 				fmt.Sprintf("%v; code: %v; message: %v", err, response.Code, response.Message),
 			)
-			return
+			return false
 		}
 		plan.ReadFromCreateResponse(response)
 
@@ -394,22 +451,13 @@ func (r *connector) Update(ctx context.Context, req resource.UpdateRequest, resp
 			TrustFingerprints(trustFingerprintsPlan).
 			DoCustom(ctx)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			diags.AddError(
 				"Unable to Update Connector Resource.",
 				fmt.Sprintf("%v; code: %v; message: %v", err, response.Code, response.Message),
 			)
-			return
-		}
-		if response.Data.SetupTests != nil && len(response.Data.SetupTests) > 0 {
-			for _, tr := range response.Data.SetupTests {
-				if tr.Status != "PASSED" && tr.Status != "SKIPPED" {
-					resp.Diagnostics.AddWarning(
-						fmt.Sprintf("Connector setup test `%v` has status `%v`", tr.Title, tr.Status),
-						tr.Message,
-					)
-				}
-			}
+			return false
 		}
+		reportSetupTests(response.Data.SetupTests, "Connector", failOnSetupTestFailurePlan, diags)
 		if !updatePerformed {
 			plan.ReadFromCreateResponse(response)
 			// Preserve plan-only attributes after reading API response
@@ -424,11 +472,11 @@ func (r *connector) Update(ctx context.Context, req resource.UpdateRequest, resp
 		// re-read connector upstream with an additional request after update
 		response, err := r.GetClient().NewConnectionDetails().ConnectionID(state.Id.ValueString()).DoCustom(ctx)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			diags.AddError(
 				"Unable to Read after Update Connector Resource.",
 				fmt.Sprintf("%v; code: %v; message: %v", err, response.Code, response.Message),
 			)
-			return
+			return false
 		}
 		plan.ReadFromResponse(response, false)
 		// Preserve plan-only attributes after reading API response
@@ -447,9 +495,10 @@ func (r *connector) Update(ctx context.Context, req resource.UpdateRequest, resp
 	if plan.TrustFingerprints.IsUnknown() {
 		plan.TrustFingerprints = state.TrustFingerprints
 	}
-
-	// Save state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	plan.FailOnSetupTestFailure = types.BoolValue(failOnSetupTestFailurePlan)
+	plan.RollbackOnFailure = types.BoolValue(core.GetBoolOrDefault(plan.RollbackOnFailure, false))
+	plan.AdoptExisting = types.BoolValue(core.GetBoolOrDefault(plan.AdoptExisting, false))
+	return true
 }
 
 func (r *connector) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -476,6 +525,33 @@ func (r *connector) Delete(ctx context.Context, req resource.DeleteRequest, resp
 	}
 }
 
+// adopt reads the existing connector as prior state and updates it to the plan, as if it had been imported before the apply.
+// Returns false if the connector couldn't be adopted.
+func (r *connector) adopt(ctx context.Context, plan *model.ConnectorResourceModel, id string, diags *diag.Diagnostics) bool {
+	response, err := r.GetClient().NewConnectionDetails().ConnectionID(id).DoCustom(ctx)
+	if err != nil {
+		diags.AddError(
+			"Unable to Adopt Connector Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, response.Code, response.Message),
+		)
+		return false
+	}
+	if response.Data.Service != plan.Service.ValueString() {
+		diags.AddError(
+			"Unable to Adopt Connector Resource.",
+			fmt.Sprintf("Connector %v with schema `%v` has service `%v`, not `%v`.", id, response.Data.Schema, response.Data.Service, plan.Service.ValueString()),
+		)
+		return false
+	}
+
+	state := *plan
+	state.ReadFromResponse(response, false)
+	state.RunSetupTests = types.BoolNull()
+	state.TrustCertificates = types.BoolNull()
+	state.TrustFingerprints = types.BoolNull()
+	return r.applyUpdate(ctx, plan, state, diags)
+}
+
 // in case if state was corrupted and computable values wasn't saved we could recover resource id using group_id and schema
 func (r *connector) recoverId(ctx context.Context, data model.ConnectorResourceModel) (string, string) {
 	id := ""
@@ -487,66 +563,38 @@ func (r *connector) recoverId(ctx context.Context, data model.ConnectorResourceM
 			destinationSchema, err := data.GetDestinatonSchemaForConfig()
 			if err == nil {
 				log = log + "\n" + fmt.Sprintf("Destination schema: \n %v", destinationSchema)
-				if prefix, ok := destinationSchema["schema_prefix"]; ok && prefix != "" {
-					schemaName = prefix.(string)
-				} else {
-					if name, ok := destinationSchema["schema"]; ok && name != "" {
-						schemaName = name.(string)
-						if table, ok := destinationSchema["table"]; ok && table != "" {
-							schemaName = schemaName + "." + table.(string)
-						}
-					}
-				}
+				schemaName = connectorSchemaName(destinationSchema)
 			} else {
 				log = log + "\n" + err.Error()
 			}
 		}
 		log = log + "\n" + fmt.Sprintf("Schema `%s`, group `%s", schemaName, groupId)
 		if schemaName != "" && groupId != "" {
-			connectorsList, err := r.
-				GetClient().
-				NewGroupListConnections().
-				GroupID(groupId).
-				Limit(1000).
-				Do(ctx)
-			found := false
-			if err == nil {
-				for _, c := range connectorsList.Data.Items {
-					if c.Schema == schemaName {
-						id = c.ID
-						found = true
-						break
-					}
-				}
-				for !found && connectorsList.Data.NextCursor != "" {
-					connectorsList, err = r.GetClient().
-						NewGroupListConnections().
-						GroupID(groupId).
-						Limit(1000).
-						Cursor(connectorsList.Data.NextCursor).
-						Do(ctx)
-					if err != nil {
-						log = log + "\n" + err.Error()
-						break
-					} else {
-						for _, c := range connectorsList.Data.Items {
-							if c.Schema == schemaName {
-								id = c.ID
-								found = true
-								break
-							}
-						}
-					}
-				}
-			} else {
+			found, err := findConnectionIdBySchema(ctx, r.GetClient(), groupId, schemaName)
+			if err != nil {
 				log = log + "\n" + err.Error()
 			}
-			if !found {
+			if found == "" {
 				log = log + "\n" + fmt.Sprintf("Can't find connector with schema = `%s` in group with id = `%s", schemaName, groupId)
 			}
+			id = found
 		} else {
 			log = log + "\n" + " not enough data in state for recovery: " + fmt.Sprintf("schema:`%s`, group:`%s", schemaName, groupId)
 		}
 	}
 	return id, log
 }
+
+// connectorSchemaName returns the name the connector schema has in the connections list for the destination schema settings.
+func connectorSchemaName(destinationSchema map[string]interface{}) string {
+	if prefix, ok := destinationSchema["schema_prefix"]; ok && prefix != "" {
+		return prefix.(string)
+	}
+	if name, ok := destinationSchema["schema"]; ok && name != "" {
+		if table, ok := destinationSchema["table"]; ok && table != "" {
+			return name.(string) + "." + table.(string)
+		}
+		return name.(string)
+	}
+	return ""
+}
@@ -113,6 +113,7 @@ func upgradeConnectorState(ctx context.Context, req resource.UpgradeStateRequest
 			"group_id":                  rawState["group_id"],
 			"service":                   rawState["service"],
 			"timeouts":                  rawState["timeouts"],
+			"wait_for":                  tftypes.NewValue(getConnectorStateModel(5).(tftypes.Object).AttributeTypes["wait_for"], nil),
 			"networking_method":         tftypes.NewValue(tftypes.String, nil),
 			"proxy_agent_id":            tftypes.NewValue(tftypes.String, nil),
 			"private_link_id":           tftypes.NewValue(tftypes.String, nil),
@@ -122,6 +123,9 @@ func upgradeConnectorState(ctx context.Context, req resource.UpgradeStateRequest
 			"run_setup_tests":    convertStringStateValueToBool("run_setup_tests", rawState["run_setup_tests"], resp.Diagnostics),
 			"trust_fingerprints": convertStringStateValueToBool("trust_fingerprints", rawState["trust_fingerprints"], resp.Diagnostics),
 			"trust_certificates": convertStringStateValueToBool("trust_certificates", rawState["trust_certificates"], resp.Diagnostics),
+			"fail_on_setup_test_failure": tftypes.NewValue(tftypes.Bool, nil),
+			"rollback_on_failure":        tftypes.NewValue(tftypes.Bool, nil),
+			"adopt_existing":             tftypes.NewValue(tftypes.Bool, nil),
 
 			"config": 				config,
 			"auth":   				auth,
@@ -150,6 +154,16 @@ func getConnectorStateModel(version int) tftypes.Type {
 	}
 	if version >= 3 && version <= 5 {
 		if version == 5 {
+			base["fail_on_setup_test_failure"] = tftypes.Bool
+			base["rollback_on_failure"] = tftypes.Bool
+			base["adopt_existing"] = tftypes.Bool
+			base["wait_for"] = tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"setup_state_connected": tftypes.Bool,
+					"initial_sync_complete": tftypes.Bool,
+					"unpause":               tftypes.Bool,
+				},
+			}
 			base["destination_schema"] = tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"name":   			tftypes.String,
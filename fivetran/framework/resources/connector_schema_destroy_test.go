@@ -0,0 +1,151 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/fivetran/go-fivetran"
+	httputils "github.com/fivetran/go-fivetran/http_utils"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// schemaPatchHTTPClient serves schema details and records bodies of PATCH requests and paths of POST requests.
+type schemaPatchHTTPClient struct {
+	details string
+	mutex   sync.Mutex
+	patches []map[string]interface{}
+	posts   []string
+}
+
+func (c *schemaPatchHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodPost {
+		c.mutex.Lock()
+		c.posts = append(c.posts, req.URL.Path)
+		c.mutex.Unlock()
+	}
+	if req.Method == http.MethodPatch {
+		body, _ := io.ReadAll(req.Body)
+		patch := map[string]interface{}{}
+		_ = json.Unmarshal(body, &patch)
+		c.mutex.Lock()
+		c.patches = append(c.patches, patch)
+		c.mutex.Unlock()
+	}
+	return staticHTTPClient{body: c.details}.Do(req)
+}
+
+func connectorSchemaForDestroy(t *testing.T, httpClient httputils.HttpClient, onDestroy interface{}) (*connectorSchema, tfsdk.State) {
+	t.Helper()
+	client := fivetran.New("key", "secret")
+	client.SetHttpClient(httpClient)
+
+	r := &connectorSchema{}
+	configureProviderResource(t, &r.ProviderResource, client, &sync.Map{}, false)
+	state := nullState(context.Background(), connectorSchemaResourceSchema(t, r))
+	setStateAttributes(t, &state, map[string]interface{}{
+		"id":           "connector_id",
+		"connector_id": "connector_id",
+		"on_destroy":   onDestroy,
+	})
+	return r, state
+}
+
+func TestConnectorSchemaDeleteRetainsByDefault(t *testing.T) {
+	t.Parallel()
+
+	for _, onDestroy := range []interface{}{nil, "retain"} {
+		// any request fails the test
+		r, state := connectorSchemaForDestroy(t, errorHTTPClient{}, onDestroy)
+		var resp resource.DeleteResponse
+		r.Delete(context.Background(), resource.DeleteRequest{State: state}, &resp)
+		assertNoDiagnostics(t, resp.Diagnostics)
+	}
+}
+
+func TestConnectorSchemaDeleteResetsToAllowAll(t *testing.T) {
+	t.Parallel()
+
+	httpClient := &schemaPatchHTTPClient{details: `{"code":"Success","data":{
+		"schema_change_handling":"BLOCK_ALL",
+		"schemas":{"public":{"enabled":false,"tables":{
+			"orders":{"enabled":false},
+			"locked":{"enabled":false,"enabled_patch_settings":{"allowed":false,"reason":"System table"}}
+		}}}}}`}
+	r, state := connectorSchemaForDestroy(t, httpClient, "reset_to_allow_all")
+
+	var resp resource.DeleteResponse
+	r.Delete(context.Background(), resource.DeleteRequest{State: state}, &resp)
+	assertNoDiagnostics(t, resp.Diagnostics)
+
+	if len(httpClient.patches) != 1 {
+		t.Fatalf("patch requests = %v, want 1", len(httpClient.patches))
+	}
+	patch := httpClient.patches[0]
+	if patch["schema_change_handling"] != "ALLOW_ALL" {
+		t.Errorf("schema_change_handling = %v, want ALLOW_ALL", patch["schema_change_handling"])
+	}
+	public := patch["schemas"].(map[string]interface{})["public"].(map[string]interface{})
+	if public["enabled"] != true {
+		t.Errorf("schema should be enabled: %v", public)
+	}
+	tables := public["tables"].(map[string]interface{})
+	if tables["orders"].(map[string]interface{})["enabled"] != true {
+		t.Errorf("orders should be enabled: %v", tables)
+	}
+	if _, ok := tables["locked"]; ok {
+		t.Errorf("locked table should not be patched: %v", tables)
+	}
+}
+
+func TestConnectorSchemaDeleteBlocksAll(t *testing.T) {
+	t.Parallel()
+
+	httpClient := &schemaPatchHTTPClient{details: `{"code":"Success","data":{
+		"schema_change_handling":"ALLOW_ALL",
+		"schemas":{"public":{"enabled":true,"tables":{"orders":{"enabled":true}}}}}}`}
+	r, state := connectorSchemaForDestroy(t, httpClient, "block_all")
+
+	var resp resource.DeleteResponse
+	r.Delete(context.Background(), resource.DeleteRequest{State: state}, &resp)
+	assertNoDiagnostics(t, resp.Diagnostics)
+
+	if len(httpClient.patches) != 1 {
+		t.Fatalf("patch requests = %v, want 1", len(httpClient.patches))
+	}
+	patch := httpClient.patches[0]
+	if patch["schema_change_handling"] != "BLOCK_ALL" {
+		t.Errorf("schema_change_handling = %v, want BLOCK_ALL", patch["schema_change_handling"])
+	}
+	if public := patch["schemas"].(map[string]interface{})["public"].(map[string]interface{}); public["enabled"] != false {
+		t.Errorf("schema should be disabled: %v", public)
+	}
+}
+
+func TestConnectorSchemaModifyPlanNotesOnDestroy(t *testing.T) {
+	t.Parallel()
+
+	for onDestroy, summary := range map[string]string{
+		"retain":             "Connector Schema Config Will Be Retained.",
+		"block_all":          "Connector Schema Config Will Be Blocked.",
+		"reset_to_allow_all": "Connector Schema Config Will Be Reset.",
+	} {
+		r, state := connectorSchemaForDestroy(t, errorHTTPClient{}, onDestroy)
+		req := resource.ModifyPlanRequest{
+			State: state,
+			Plan:  tfsdk.Plan{Raw: tftypes.NewValue(state.Raw.Type(), nil), Schema: state.Schema},
+		}
+		resp := resource.ModifyPlanResponse{Plan: req.Plan}
+		r.ModifyPlan(context.Background(), req, &resp)
+
+		assertWarningCount(t, resp.Diagnostics, 1)
+		if warnings := resp.Diagnostics.Warnings(); len(warnings) == 1 && warnings[0].Summary() != summary {
+			t.Errorf("on_destroy = %v: warning %q, want %q", onDestroy, warnings[0].Summary(), summary)
+		}
+	}
+}
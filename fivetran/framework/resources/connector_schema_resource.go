@@ -3,6 +3,7 @@ package resources
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/fivetran/go-fivetran"
 	"github.com/fivetran/go-fivetran/connections"
@@ -11,8 +12,8 @@ import (
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
 	configSchema "github.com/fivetran/terraform-provider-fivetran/modules/connector/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -37,7 +38,8 @@ func (r *connectorSchema) Schema(ctx context.Context, req resource.SchemaRequest
 }
 
 func (r *connectorSchema) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id, err := resolveConnectionImportId(ctx, r.GetClient(), req.ID)
+	importResolvedId(ctx, resp, "Unable to Import Connector Schema Resource.", id, err, "id")
 }
 
 func (r *connectorSchema) reloadSchema(ctx context.Context, connectorID string, diag diag.Diagnostics) connections.ConnectionSchemaDetailsResponse {
@@ -103,6 +105,7 @@ func (r *connectorSchema) createNewSchema(ctx context.Context, connectorID strin
 		SchemaChangeHandling(schemaChangeHandling)
 
 	// we should not parse response here because it will contain only applied diffs, not the whole configuration
+	r.invalidateColumns(connectorID)
 	applyResponse, err := svc.Do(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -111,6 +114,12 @@ func (r *connectorSchema) createNewSchema(ctx context.Context, connectorID strin
 		)
 		return
 	}
+	if data.IsRulesDefined() {
+		resp.Diagnostics.AddWarning(
+			"Schema rules are not applied.",
+			"The connector doesn't have schema config yet, `rule` blocks will be applied on the next apply once the source schema is captured.",
+		)
+	}
 	data.ReadFromResponse(applyResponse, false, &resp.Diagnostics)
 	data.Id = types.StringValue(connectorID)
 	data.ConnectorId = types.StringValue(connectorID)
@@ -213,7 +222,7 @@ func (r *connectorSchema) Create(ctx context.Context, req resource.CreateRequest
 		}
 	} else {
 		// We might have to refresh schema, not all tables might be saved in current configuration
-		err, needReloadSchema := data.ValidateSchemaElements(schemaResponse, false, *client, ctx)
+		err, needReloadSchema := data.ValidateSchemaElements(schemaResponse, false, *client, ctx, r.GetColumnFetcher())
 		if err != nil {
 			// Reload as schema might be out of sync with the real source schema
 			needReload = needReloadSchema
@@ -234,7 +243,7 @@ func (r *connectorSchema) Create(ctx context.Context, req resource.CreateRequest
 		}
 		// validate request one more time after reload schema
 		forceValidateColumns := schemaChangeHandling == configSchema.BLOCK_ALL
-		err, _ = data.ValidateSchemaElements(schemaResponse, forceValidateColumns, *client, ctx)
+		err, _ = data.ValidateSchemaElements(schemaResponse, forceValidateColumns, *client, ctx, r.GetColumnFetcher())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to create Connector Schema Resource.",
@@ -262,29 +271,28 @@ func (r *connectorSchema) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	// tables with changed sync mode or column hashing, resynced once the whole config is applied
+	var resyncTables []configSchema.SchemaTable
 	if config.HasUpdates() {
-		// applying patch
-		svc := config.PrepareRequest(client.NewConnectionSchemaUpdateService())
-		svc.ConnectionID(connectorID)
-		// update schema_change_handling if needed
+		// applying patch, update schema_change_handling if needed
+		patchSchemaChangeHandling := ""
 		if schemaChangeHandling != "" && schemaChangeHandling != schemaResponse.Data.SchemaChangeHandling {
-			svc.SchemaChangeHandling(schemaChangeHandling)
+			patchSchemaChangeHandling = schemaChangeHandling
 		}
-		// we should not parse response here because it will contain only applied diffs, not the whole configuration
-		applyResponse, err := svc.Do(ctx)
-
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Unable to Create Connector Schema Resource.",
-				fmt.Sprintf("Error while applying schema config patch. %v; code: %v; message: %v", err, applyResponse.Code, applyResponse.Message),
-			)
+		applied := r.applySchemaPatch(ctx, connectorID, config, patchSchemaChangeHandling, data.GetMaxTablesPerRequest(), "Unable to Create Connector Schema Resource.", &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			if applied {
+				r.setStateAfterPartialPatch(ctx, connectorID, &data, &resp.State, &resp.Diagnostics)
+			}
 			return
 		}
+		resyncTables = append(resyncTables, config.TablesToResync()...)
 	} else {
 		// we update only schema_change_handling if needed
 		if schemaChangeHandling != "" && schemaChangeHandling != schemaResponse.Data.SchemaChangeHandling {
 			svc := client.NewConnectionSchemaUpdateService().ConnectionID(connectorID)
 			svc.SchemaChangeHandling(schemaChangeHandling)
+			r.invalidateColumns(connectorID)
 			schResponse, err := svc.Do(ctx)
 			if err != nil {
 				resp.Diagnostics.AddError(
@@ -323,18 +331,14 @@ func (r *connectorSchema) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 	if configAfterApply.HasUpdates() {
-		svc := configAfterApply.PrepareRequest(client.NewConnectionSchemaUpdateService())
-		svc.ConnectionID(connectorID)
-		// we should not parse response here because it will contain only applied diffs, not the whole configuration
-		applyResponse, err := svc.Do(ctx)
-
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Unable to Create Connector Schema Resource.",
-				fmt.Sprintf("Error while applying schema config patch. %v; code: %v; message: %v", err, applyResponse.Code, applyResponse.Message),
-			)
+		applied := r.applySchemaPatch(ctx, connectorID, configAfterApply, "", data.GetMaxTablesPerRequest(), "Unable to Create Connector Schema Resource.", &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			if applied {
+				r.setStateAfterPartialPatch(ctx, connectorID, &data, &resp.State, &resp.Diagnostics)
+			}
 			return
 		}
+		resyncTables = append(resyncTables, configAfterApply.TablesToResync()...)
 
 		// We need to re-read schema
 		schemaResponse, err = client.NewConnectionSchemaDetails().ConnectionID(connectorID).Do(ctx)
@@ -347,8 +351,14 @@ func (r *connectorSchema) Create(ctx context.Context, req resource.CreateRequest
 		}
 	}
 
+	if data.ResyncOnChange.ValueBool() {
+		r.resyncTables(ctx, connectorID, resyncTables, &resp.Diagnostics)
+	}
+
 	// read data from response and merge with existing config
+	plannedResolvedRules := data.ResolvedRules
 	data.ReadFromResponse(schemaResponse, false, &resp.Diagnostics)
+	keepPlannedResolvedRules(plannedResolvedRules, &data)
 	data.Id = types.StringValue(connectorID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -437,13 +447,13 @@ func (r *connectorSchema) Update(ctx context.Context, req resource.UpdateRequest
 	forceColumnsPopulationAfterSchemaReloaded := false
 	if plan.ValidationLevel.ValueString() != "NONE" {
 		// Before applying changes we should validate existing state and planned changes and decide if we need to reload schema
-		err, _ := plan.ValidateSchemaElements(schemaResponse, false, *client, ctx)
+		err, _ := plan.ValidateSchemaElements(schemaResponse, false, *client, ctx, r.GetColumnFetcher())
 		if err != nil {
 			schemaResponse = r.reloadSchema(ctx, connectorID, resp.Diagnostics)
 			forceColumnsPopulationAfterSchemaReloaded = (plan.SchemaChangeHandling.ValueString() == configSchema.BLOCK_ALL)
 		}
 
-		err, _ = plan.ValidateSchemaElements(schemaResponse, forceColumnsPopulationAfterSchemaReloaded, *client, ctx)
+		err, _ = plan.ValidateSchemaElements(schemaResponse, forceColumnsPopulationAfterSchemaReloaded, *client, ctx, r.GetColumnFetcher())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to update Connector Schema Resource.",
@@ -471,30 +481,28 @@ func (r *connectorSchema) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	// tables with changed sync mode or column hashing, resynced once the whole config is applied
+	var resyncTables []configSchema.SchemaTable
 	if config.HasUpdates() {
-		// applying patch
-		svc := config.PrepareRequest(client.NewConnectionSchemaUpdateService())
-		svc.ConnectionID(connectorID)
-		// update schema_change_handling as well if needed
+		// applying patch, update schema_change_handling as well if needed
+		patchSchemaChangeHandling := ""
 		if plan.SchemaChangeHandling.String() != "" && plan.SchemaChangeHandling != state.SchemaChangeHandling {
-			svc.SchemaChangeHandling(plan.SchemaChangeHandling.ValueString())
+			patchSchemaChangeHandling = plan.SchemaChangeHandling.ValueString()
 		}
-		// we should not parse response here because it will contain only applied diffs, not the whole configuration
-		applyResponse, err := svc.Do(ctx)
-
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Unable to Create Connector Schema Resource.",
-				fmt.Sprintf("Error while applying schema config patch. %v; code: %v; message: %v", err, applyResponse.Code, applyResponse.Message),
-			)
+		applied := r.applySchemaPatch(ctx, connectorID, config, patchSchemaChangeHandling, plan.GetMaxTablesPerRequest(), "Unable to Update Connector Schema Resource.", &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			if applied {
+				r.setStateAfterPartialPatch(ctx, connectorID, &plan, &resp.State, &resp.Diagnostics)
+			}
 			return
 		}
-
+		resyncTables = append(resyncTables, config.TablesToResync()...)
 	} else {
 		// update schema_change_handling if needed
 		if plan.SchemaChangeHandling.String() != "" && plan.SchemaChangeHandling != state.SchemaChangeHandling {
 			svc := client.NewConnectionSchemaUpdateService().ConnectionID(connectorID)
 			svc.SchemaChangeHandling(plan.SchemaChangeHandling.ValueString())
+			r.invalidateColumns(connectorID)
 			schResponse, err := svc.Do(ctx)
 			if err != nil {
 				resp.Diagnostics.AddError(
@@ -519,7 +527,7 @@ func (r *connectorSchema) Update(ctx context.Context, req resource.UpdateRequest
 
 	if forceColumnsPopulationAfterSchemaReloaded {
 		// response doesn't contain columns, need to go through tables and get columns
-		err, _ = plan.ValidateSchemaElements(schemaResponse, forceColumnsPopulationAfterSchemaReloaded, *client, ctx)
+		err, _ = plan.ValidateSchemaElements(schemaResponse, forceColumnsPopulationAfterSchemaReloaded, *client, ctx, r.GetColumnFetcher())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to update Connector Schema Resource.",
@@ -544,16 +552,14 @@ func (r *connectorSchema) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	if configAfterApply.HasUpdates() {
-		svc := configAfterApply.PrepareRequest(client.NewConnectionSchemaUpdateService())
-		svc.ConnectionID(connectorID)
-		applyResponse, err := svc.Do(ctx)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Unable to Create Connector Schema Resource.",
-				fmt.Sprintf("Error while applying schema config patch. %v; code: %v; message: %v", err, applyResponse.Code, applyResponse.Message),
-			)
+		applied := r.applySchemaPatch(ctx, connectorID, configAfterApply, "", plan.GetMaxTablesPerRequest(), "Unable to Update Connector Schema Resource.", &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			if applied {
+				r.setStateAfterPartialPatch(ctx, connectorID, &plan, &resp.State, &resp.Diagnostics)
+			}
 			return
 		}
+		resyncTables = append(resyncTables, configAfterApply.TablesToResync()...)
 	}
 
 	// re-read schema after apply changes
@@ -566,12 +572,180 @@ func (r *connectorSchema) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	if plan.ResyncOnChange.ValueBool() {
+		r.resyncTables(ctx, connectorID, resyncTables, &resp.Diagnostics)
+	}
+
+	plannedResolvedRules := plan.ResolvedRules
 	plan.ReadFromResponse(schemaResponse, false, &resp.Diagnostics)
+	keepPlannedResolvedRules(plannedResolvedRules, &plan)
 	plan.Id = types.StringValue(connectorID)
 	plan.ConnectorId = types.StringValue(connectorID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+// applySchemaPatch applies the schema config patch, split into requests of at most maxTables updated tables.
+// A failed request is reported on its own and doesn't stop the remaining ones. Returns true if any request was applied.
+func (r *connectorSchema) applySchemaPatch(
+	ctx context.Context,
+	connectorID string,
+	config configSchema.SchemaConfig,
+	schemaChangeHandling string,
+	maxTables int,
+	summary string,
+	diags *diag.Diagnostics) bool {
+	client := r.GetClient()
+	patches := config.SplitPatch(maxTables)
+	applied := false
+	for i, patch := range patches {
+		svc := patch.PrepareRequest(client.NewConnectionSchemaUpdateService())
+		svc.ConnectionID(connectorID)
+		if i == 0 && schemaChangeHandling != "" {
+			svc.SchemaChangeHandling(schemaChangeHandling)
+		}
+		// we should not parse response here because it will contain only applied diffs, not the whole configuration
+		r.invalidateColumns(connectorID)
+		applyResponse, err := svc.Do(ctx)
+		if err != nil {
+			if len(patches) > 1 {
+				diags.AddError(
+					summary,
+					fmt.Sprintf("Error while applying schema config patch request %v of %v (schemas: %v; tables: %v). %v; code: %v; message: %v",
+						i+1, len(patches), strings.Join(patch.Schemas, ", "), patch.Tables, err, applyResponse.Code, applyResponse.Message),
+				)
+			} else {
+				diags.AddError(
+					summary,
+					fmt.Sprintf("Error while applying schema config patch. %v; code: %v; message: %v", err, applyResponse.Code, applyResponse.Message),
+				)
+			}
+			continue
+		}
+		applied = true
+	}
+	return applied
+}
+
+// setStateAfterPartialPatch re-reads the schema config, so the state reflects requests applied before the failure.
+func (r *connectorSchema) setStateAfterPartialPatch(ctx context.Context, connectorID string, data *model.ConnectorSchemaResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	schemaResponse, err := r.GetClient().NewConnectionSchemaDetails().ConnectionID(connectorID).Do(ctx)
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Connector Schema Resource.",
+			fmt.Sprintf("Error while reading schema after partially applied schema config patch. %v; code: %v; message: %v", err, schemaResponse.Code, schemaResponse.Message),
+		)
+		return
+	}
+	data.ReadFromResponse(schemaResponse, false, diags)
+	data.Id = types.StringValue(connectorID)
+	data.ConnectorId = types.StringValue(connectorID)
+	diags.Append(state.Set(ctx, data)...)
+}
+
+// resyncTables triggers a resync of the given tables and lists them in a warning, tables patched twice are resynced once.
+// Failed resyncs are reported as warnings as well: the schema config is already applied at this point.
+func (r *connectorSchema) resyncTables(ctx context.Context, connectorID string, tables []configSchema.SchemaTable, diags *diag.Diagnostics) {
+	resynced := make([]string, 0)
+	failed := make([]string, 0)
+	seen := make(map[configSchema.SchemaTable]bool)
+	for _, table := range tables {
+		if seen[table] {
+			continue
+		}
+		seen[table] = true
+		name := fmt.Sprintf("%v.%v", table.Schema, table.Table)
+		resyncResponse, err := r.GetClient().NewConnectionReSyncTable().ConnectionID(connectorID).Schema(table.Schema).Table(table.Table).Do(ctx)
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("%v: %v; code: %v; message: %v", name, err, resyncResponse.Code, resyncResponse.Message))
+			continue
+		}
+		resynced = append(resynced, name)
+	}
+	if len(resynced) > 0 {
+		diags.AddWarning(
+			"Connector Schema Tables Resynced.",
+			fmt.Sprintf("Sync mode or column hashing of the following tables of connection %v was changed, the tables were resynced (resync_on_change = true):\n  - %v",
+				connectorID, strings.Join(resynced, "\n  - ")),
+		)
+	}
+	if len(failed) > 0 {
+		diags.AddWarning(
+			"Unable to Resync Connector Schema Tables.",
+			fmt.Sprintf("Sync mode or column hashing of the following tables of connection %v was changed, but the resync failed. Resync the tables manually:\n  - %v",
+				connectorID, strings.Join(failed, "\n  - ")),
+		)
+	}
+}
+
+// invalidateColumns drops columns fetched for validation: they are outdated once the schema config is changed.
+// Schema reload preserves column settings, so columns fetched before reload are reused.
+func (r *connectorSchema) invalidateColumns(connectorID string) {
+	if fetcher := r.GetColumnFetcher(); fetcher != nil {
+		fetcher.Invalidate(connectorID)
+	}
+}
+
 func (r *connectorSchema) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// Nothing to do
+	var data model.ConnectorSchemaResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy := data.GetOnDestroyPolicy()
+	if policy == "" {
+		// on_destroy = "retain": nothing to do
+		return
+	}
+
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	client := r.GetClient()
+	connectorID := data.Id.ValueString()
+	schemaResponse, err := client.NewConnectionSchemaDetails().ConnectionID(connectorID).Do(ctx)
+	if err != nil {
+		if schemaResponse.Code == "NotFound_SchemaConfig" || schemaResponse.Code == "NotFound_Connector" || schemaResponse.Code == "NotFound_Connection" {
+			// nothing to reset
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to Delete Connector Schema Resource.",
+			fmt.Sprintf("Error while retrieving existing schema. %v; code: %v; message: %v", err, schemaResponse.Code, schemaResponse.Message),
+		)
+		return
+	}
+
+	// align all not locked elements to the policy
+	config := configSchema.SchemaConfig{}
+	config.ReadFromResponse(schemaResponse)
+	if err := config.Override(nil, policy); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Connector Schema Resource.",
+			fmt.Sprintf("Error while preparing schema config patch. %v", err),
+		)
+		return
+	}
+
+	if config.HasUpdates() {
+		r.applySchemaPatch(ctx, connectorID, config, policy, data.GetMaxTablesPerRequest(), "Unable to Delete Connector Schema Resource.", &resp.Diagnostics)
+	} else if schemaResponse.Data.SchemaChangeHandling != policy {
+		// we update only schema_change_handling
+		svc := client.NewConnectionSchemaUpdateService().ConnectionID(connectorID)
+		svc.SchemaChangeHandling(policy)
+		r.invalidateColumns(connectorID)
+		schResponse, err := svc.Do(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Delete Connector Schema Resource.",
+				fmt.Sprintf("Error while applying schema change handling policy. %v; code: %v; message: %v", err, schResponse.Code, schResponse.Message),
+			)
+		}
+	}
 }
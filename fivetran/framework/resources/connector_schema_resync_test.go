@@ -0,0 +1,75 @@
+package resources
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestConnectorSchemaUpdateResyncsChangedTables(t *testing.T) {
+	t.Parallel()
+
+	httpClient := &schemaPatchHTTPClient{details: `{"code":"Success","data":{
+		"schema_change_handling":"ALLOW_ALL",
+		"schemas":{"public":{"enabled":true,"tables":{
+			"orders":{"enabled":true,"sync_mode":"SOFT_DELETE"},
+			"users":{"enabled":true,"columns":{"email":{"enabled":true,"hashed":false}}},
+			"events":{"enabled":true}
+		}}}}}`}
+	client := fivetran.New("key", "secret")
+	client.SetHttpClient(httpClient)
+	r := &connectorSchema{}
+	configureProviderResource(t, &r.ProviderResource, client, &sync.Map{}, false)
+
+	s := connectorSchemaResourceSchema(t, r)
+	for _, resyncOnChange := range []bool{false, true} {
+		httpClient.posts = nil
+		config := configWithValues(t, s, map[string]tftypes.Value{
+			"id":                     tftypes.NewValue(tftypes.String, "connector_id"),
+			"connector_id":           tftypes.NewValue(tftypes.String, "connector_id"),
+			"schema_change_handling": tftypes.NewValue(tftypes.String, "ALLOW_ALL"),
+			"validation_level":       tftypes.NewValue(tftypes.String, "NONE"),
+			"resync_on_change":       tftypes.NewValue(tftypes.Bool, resyncOnChange),
+			"schemas_json": tftypes.NewValue(tftypes.String, `{"public":{"enabled":true,"tables":{
+				"orders":{"sync_mode":"HISTORY"},
+				"users":{"columns":{"email":{"hashed":true}}},
+				"events":{"enabled":false}
+			}}}`),
+		}, nil)
+		req := resource.UpdateRequest{
+			Config: config,
+			Plan:   tfsdk.Plan{Raw: config.Raw, Schema: s},
+			State:  tfsdk.State{Raw: config.Raw, Schema: s},
+		}
+		resp := resource.UpdateResponse{State: tfsdk.State{Raw: config.Raw, Schema: s}}
+		r.Update(context.Background(), req, &resp)
+
+		if !resyncOnChange {
+			assertNoDiagnostics(t, resp.Diagnostics)
+			if len(httpClient.posts) != 0 {
+				t.Errorf("tables should not be resynced without resync_on_change: %v", httpClient.posts)
+			}
+			continue
+		}
+
+		assertErrorCount(t, resp.Diagnostics, 0)
+		assertWarningCount(t, resp.Diagnostics, 1)
+		expected := []string{
+			"/v1/connections/connector_id/schemas/public/tables/orders/resync",
+			"/v1/connections/connector_id/schemas/public/tables/users/resync",
+		}
+		if !reflect.DeepEqual(httpClient.posts, expected) {
+			t.Errorf("resync requests = %v, want %v", httpClient.posts, expected)
+		}
+		if detail := resp.Diagnostics.Warnings()[0].Detail(); !strings.Contains(detail, "public.orders") || strings.Contains(detail, "public.events") {
+			t.Errorf("unexpected warning: %v", detail)
+		}
+	}
+}
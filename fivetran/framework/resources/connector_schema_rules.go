@@ -0,0 +1,136 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithValidateConfig = &connectorSchema{}
+var _ resource.ResourceWithModifyPlan = &connectorSchema{}
+
+func (r *connectorSchema) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data model.ConnectorSchemaResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || !data.IsRulesDefined() || data.HasUnknownRules() {
+		return
+	}
+
+	for i, rule := range data.GetRules() {
+		if err := rule.Validate(); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rule").AtListIndex(i),
+				"Invalid Schema Rule.",
+				err.Error(),
+			)
+		}
+	}
+}
+
+// ModifyPlan resolves rules against the upstream schema config, so the plan shows which elements the rules affect.
+// The value stays unknown when the upstream schema config is not available yet.
+// Configured changes of locked elements and primary key columns are reported as plan errors.
+func (r *connectorSchema) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// Resource is being destroyed
+		addOnDestroyNote(ctx, req.State, &resp.Diagnostics)
+		return
+	}
+
+	var plan model.ConnectorSchemaResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rulesDefined := plan.IsRulesDefined() || plan.Rules.IsUnknown()
+	if !rulesDefined {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("resolved_rules"), model.ResolvedRulesNull())...)
+	}
+
+	client := r.GetClient()
+	if client == nil {
+		return
+	}
+
+	connectorID := plan.ConnectorId
+	if connectorID.IsUnknown() && !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("connector_id"), &connectorID)...)
+	}
+	if connectorID.IsUnknown() || connectorID.IsNull() || connectorID.ValueString() == "" {
+		return
+	}
+
+	// only explicitly configured values are checked, computed values are unknown or copied from state in plan
+	var config model.ConnectorSchemaResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if ownership := r.GetTableOwnership(); ownership != nil {
+		explicit, aligned := ownership.ClaimSchemaConfig(connectorID.ValueString(), config.GetConfiguredSchemas())
+		addSchemaTableConflicts(&resp.Diagnostics, connectorID.ValueString(), explicit, aligned)
+	}
+	checkElements := config.IsLegacySchemaDefined() || config.IsMappedSchemaDefined() || config.IsRawSchemaDefined() || config.IsRulesDefined()
+	resolveRules := rulesDefined && !plan.HasUnknownRules()
+	if !checkElements && !resolveRules {
+		return
+	}
+
+	schemaResponse, err := client.NewConnectionSchemaDetails().ConnectionID(connectorID.ValueString()).Do(ctx)
+	if err != nil {
+		// schema config might not exist yet, rules are resolved and elements are validated on apply
+		return
+	}
+
+	if resolveRules {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("resolved_rules"), plan.ResolveRules(schemaResponse))...)
+	}
+	if checkElements {
+		for _, err := range config.CheckConfiguredElements(schemaResponse) {
+			resp.Diagnostics.AddError("Invalid Schema Config.", err.Error())
+		}
+	}
+}
+
+// addOnDestroyNote tells in the destroy plan what happens with the connection schema config.
+func addOnDestroyNote(ctx context.Context, state tfsdk.State, diags *diag.Diagnostics) {
+	var data model.ConnectorSchemaResourceModel
+	if state.Raw.IsNull() || state.Get(ctx, &data).HasError() {
+		return
+	}
+
+	connectorID := data.Id.ValueString()
+	switch data.GetOnDestroyPolicy() {
+	case "BLOCK_ALL":
+		diags.AddWarning(
+			"Connector Schema Config Will Be Blocked.",
+			fmt.Sprintf("Destroying the resource sets `schema_change_handling` of connection %v to `BLOCK_ALL` and disables all schemas, tables and columns that are not locked (on_destroy = \"block_all\").", connectorID),
+		)
+	case "ALLOW_ALL":
+		diags.AddWarning(
+			"Connector Schema Config Will Be Reset.",
+			fmt.Sprintf("Destroying the resource sets `schema_change_handling` of connection %v to `ALLOW_ALL` and enables all schemas, tables and columns that are not locked (on_destroy = \"reset_to_allow_all\").", connectorID),
+		)
+	default:
+		diags.AddWarning(
+			"Connector Schema Config Will Be Retained.",
+			fmt.Sprintf("Destroying the resource leaves the schema config of connection %v as is. Set `on_destroy` to \"block_all\" or \"reset_to_allow_all\" to reset it on destroy.", connectorID),
+		)
+	}
+}
+
+// keepPlannedResolvedRules keeps the planned `resolved_rules` value: the upstream schema might be reloaded during apply,
+// new elements matched by rules are reported on the next refresh.
+func keepPlannedResolvedRules(planned types.Map, data *model.ConnectorSchemaResourceModel) {
+	if !planned.IsUnknown() {
+		data.ResolvedRules = planned
+	}
+}
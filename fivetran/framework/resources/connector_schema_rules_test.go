@@ -0,0 +1,169 @@
+package resources
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestConnectorSchemaValidateConfigRules(t *testing.T) {
+	t.Parallel()
+
+	r := &connectorSchema{}
+	s := connectorSchemaResourceSchema(t, r)
+
+	req := resource.ValidateConfigRequest{Config: configWithValues(t, s, map[string]tftypes.Value{
+		"connector_id": tftypes.NewValue(tftypes.String, "connector_id"),
+		"rule": schemaRules(t, s,
+			map[string]tftypes.Value{"table": tftypes.NewValue(tftypes.String, "^stg_.*"), "enabled": tftypes.NewValue(tftypes.Bool, true)},
+			map[string]tftypes.Value{"table": tftypes.NewValue(tftypes.String, "("), "enabled": tftypes.NewValue(tftypes.Bool, false)},
+			map[string]tftypes.Value{"table": tftypes.NewValue(tftypes.String, "users"), "hashed": tftypes.NewValue(tftypes.Bool, true)},
+		),
+	}, nil)}
+
+	var resp resource.ValidateConfigResponse
+	r.ValidateConfig(context.Background(), req, &resp)
+	assertErrorCount(t, resp.Diagnostics, 2)
+	for i, d := range resp.Diagnostics.Errors() {
+		want := path.Root("rule").AtListIndex(i + 1)
+		if withPath, ok := d.(interface{ Path() path.Path }); !ok || !withPath.Path().Equal(want) {
+			t.Errorf("error %d is not reported at %v: %v", i, want, d)
+		}
+	}
+}
+
+func TestConnectorSchemaModifyPlanResolvesRules(t *testing.T) {
+	t.Parallel()
+
+	client := fivetran.New("key", "secret")
+	client.SetHttpClient(staticHTTPClient{body: `{"code":"Success","data":{
+		"schema_change_handling":"ALLOW_ALL",
+		"schemas":{"public":{"enabled":true,"tables":{
+			"stg_orders":{"enabled":false},
+			"orders":{"enabled":true},
+			"audit_log":{"enabled":true,"columns":{"email":{"enabled":true,"hashed":false}}}
+		}}}}}`})
+
+	r := &connectorSchema{}
+	configureProviderResource(t, &r.ProviderResource, client, &sync.Map{}, false)
+	s := connectorSchemaResourceSchema(t, r)
+
+	config := configWithValues(t, s, map[string]tftypes.Value{
+		"connector_id":     tftypes.NewValue(tftypes.String, "connector_id"),
+		"validation_level": tftypes.NewValue(tftypes.String, "TABLES"),
+		"resolved_rules":   tftypes.NewValue(s.Type().TerraformType(context.Background()).(tftypes.Object).AttributeTypes["resolved_rules"], tftypes.UnknownValue),
+		"rule": schemaRules(t, s,
+			map[string]tftypes.Value{"table": tftypes.NewValue(tftypes.String, "^stg_.*"), "enabled": tftypes.NewValue(tftypes.Bool, true)},
+			map[string]tftypes.Value{"table": tftypes.NewValue(tftypes.String, "audit_.*"), "sync_mode": tftypes.NewValue(tftypes.String, "HISTORY")},
+			map[string]tftypes.Value{"column": tftypes.NewValue(tftypes.String, "(?i)email|phone"), "hashed": tftypes.NewValue(tftypes.Bool, true)},
+		),
+	}, nil)
+
+	req := resource.ModifyPlanRequest{
+		Config: config,
+		Plan:   tfsdk.Plan{Raw: config.Raw, Schema: s},
+		State:  tfsdk.State{Raw: tftypes.NewValue(config.Raw.Type(), nil), Schema: s},
+	}
+	resp := resource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(context.Background(), req, &resp)
+	assertNoDiagnostics(t, resp.Diagnostics)
+
+	var resolved map[string]struct {
+		Enabled  types.Bool   `tfsdk:"enabled"`
+		Hashed   types.Bool   `tfsdk:"hashed"`
+		SyncMode types.String `tfsdk:"sync_mode"`
+	}
+	if diags := resp.Plan.GetAttribute(context.Background(), path.Root("resolved_rules"), &resolved); diags.HasError() {
+		t.Fatalf("resolved_rules: %v", diags)
+	}
+	if len(resolved) != 3 {
+		t.Fatalf("resolved_rules = %v, want 3 entries", resolved)
+	}
+	if !resolved["public.stg_orders"].Enabled.ValueBool() {
+		t.Errorf("public.stg_orders should be enabled: %v", resolved["public.stg_orders"])
+	}
+	if resolved["public.audit_log"].SyncMode.ValueString() != "HISTORY" {
+		t.Errorf("public.audit_log sync_mode = %v", resolved["public.audit_log"].SyncMode)
+	}
+	if !resolved["public.audit_log.email"].Hashed.ValueBool() || !resolved["public.audit_log.email"].Enabled.IsNull() {
+		t.Errorf("public.audit_log.email = %v", resolved["public.audit_log.email"])
+	}
+}
+
+func TestConnectorSchemaModifyPlanReportsLockedElements(t *testing.T) {
+	t.Parallel()
+
+	client := fivetran.New("key", "secret")
+	client.SetHttpClient(staticHTTPClient{body: `{"code":"Success","data":{
+		"schema_change_handling":"ALLOW_ALL",
+		"schemas":{"public":{"enabled":true,"tables":{
+			"locked":{"enabled":true,"enabled_patch_settings":{"allowed":false,"reason_code":"SYSTEM_TABLE","reason":"System table"}},
+			"users":{"enabled":true,"columns":{"id":{"enabled":true,"hashed":false,"is_primary_key":true}}}
+		}}}}}`})
+
+	r := &connectorSchema{}
+	configureProviderResource(t, &r.ProviderResource, client, &sync.Map{}, false)
+	s := connectorSchemaResourceSchema(t, r)
+
+	config := configWithValues(t, s, map[string]tftypes.Value{
+		"connector_id": tftypes.NewValue(tftypes.String, "connector_id"),
+		"schemas_json": tftypes.NewValue(tftypes.String, `{"public":{"tables":{"locked":{"enabled":false},"users":{"columns":{"id":{"hashed":true}}}}}}`),
+	}, nil)
+
+	req := resource.ModifyPlanRequest{
+		Config: config,
+		Plan:   tfsdk.Plan{Raw: config.Raw, Schema: s},
+		State:  tfsdk.State{Raw: tftypes.NewValue(config.Raw.Type(), nil), Schema: s},
+	}
+	resp := resource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(context.Background(), req, &resp)
+
+	assertErrorCount(t, resp.Diagnostics, 2)
+	errors := resp.Diagnostics.Errors()
+	if !strings.Contains(errors[0].Detail(), "can't be hashed") {
+		t.Errorf("unexpected error: %v", errors[0].Detail())
+	}
+	if !strings.Contains(errors[1].Detail(), "Reason: code: SYSTEM_TABLE | reason: System table.") {
+		t.Errorf("lock reason is not reported: %v", errors[1].Detail())
+	}
+}
+
+func connectorSchemaResourceSchema(t *testing.T, r *connectorSchema) schema.Schema {
+	t.Helper()
+	var resp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("schema diagnostics: %v", resp.Diagnostics)
+	}
+	return resp.Schema
+}
+
+// schemaRules builds the `rule` block list, attributes that are not given are null.
+func schemaRules(t *testing.T, s schema.Schema, rules ...map[string]tftypes.Value) tftypes.Value {
+	t.Helper()
+	listType := s.Type().TerraformType(context.Background()).(tftypes.Object).AttributeTypes["rule"].(tftypes.List)
+	ruleType := listType.ElementType.(tftypes.Object)
+
+	elements := make([]tftypes.Value, 0, len(rules))
+	for _, rule := range rules {
+		values := make(map[string]tftypes.Value, len(ruleType.AttributeTypes))
+		for name, attributeType := range ruleType.AttributeTypes {
+			if value, ok := rule[name]; ok {
+				values[name] = value
+			} else {
+				values[name] = tftypes.NewValue(attributeType, nil)
+			}
+		}
+		elements = append(elements, tftypes.NewValue(ruleType, values))
+	}
+	return tftypes.NewValue(listType, elements)
+}
@@ -0,0 +1,217 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func ConnectorSchemaTable() resource.Resource {
+	return &connectorSchemaTable{}
+}
+
+type connectorSchemaTable struct {
+	core.ProviderResource
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ resource.ResourceWithConfigure = &connectorSchemaTable{}
+var _ resource.ResourceWithImportState = &connectorSchemaTable{}
+var _ resource.ResourceWithModifyPlan = &connectorSchemaTable{}
+
+func (r *connectorSchemaTable) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connector_schema_table"
+}
+
+func (r *connectorSchemaTable) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = fivetranSchema.GetConnectorSchemaTableResourceSchema()
+}
+
+func (r *connectorSchemaTable) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	connectionId, schema, table, err := model.ParseConnectorSchemaTableId(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Import Connector Schema Table Resource.",
+			err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("connection_id"), connectionId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("schema"), schema)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("table"), table)...)
+}
+
+// ModifyPlan reports the table as managed twice, if the connection schema config is managed
+// by a `fivetran_connector_schema_config` resource planned with the same provider instance.
+func (r *connectorSchemaTable) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.GetTableOwnership() == nil {
+		return
+	}
+
+	var plan model.ConnectorSchemaTableResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.ConnectionId.IsUnknown() || plan.Schema.IsUnknown() || plan.Table.IsUnknown() {
+		return
+	}
+
+	managed, explicit := r.GetTableOwnership().ClaimTable(plan.ConnectionId.ValueString(), plan.Schema.ValueString(), plan.Table.ValueString())
+	table := fmt.Sprintf("%v.%v", plan.Schema.ValueString(), plan.Table.ValueString())
+	if explicit {
+		addSchemaTableConflicts(&resp.Diagnostics, plan.ConnectionId.ValueString(), []string{table}, nil)
+	} else if managed {
+		addSchemaTableConflicts(&resp.Diagnostics, plan.ConnectionId.ValueString(), nil, []string{table})
+	}
+}
+
+// addSchemaTableConflicts reports tables managed by both `fivetran_connector_schema_table` and `fivetran_connector_schema_config`:
+// an error for tables configured in the schema config explicitly, a warning for tables aligned to its `schema_change_handling` policy.
+func addSchemaTableConflicts(diags *diag.Diagnostics, connectionId string, explicit, aligned []string) {
+	for _, table := range explicit {
+		diags.AddError(
+			"Schema Table Managed Twice.",
+			fmt.Sprintf("Table `%v` of connection %v is managed by both `fivetran_connector_schema_table` and `fivetran_connector_schema_config` resources. "+
+				"Remove the table from one of them.", table, connectionId),
+		)
+	}
+	for _, table := range aligned {
+		diags.AddWarning(
+			"Schema Table Might Be Overridden.",
+			fmt.Sprintf("Table `%v` of connection %v is managed by `fivetran_connector_schema_table`, while the connection schema config is managed by `fivetran_connector_schema_config`. "+
+				"The schema config aligns tables it doesn't list to its `schema_change_handling` policy, so the resources might revert each other's changes.", table, connectionId),
+		)
+	}
+}
+
+func (r *connectorSchemaTable) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.ConnectorSchemaTableResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applyTableConfig(ctx, &data, "Unable to Create Connector Schema Table Resource.", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *connectorSchemaTable) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.ConnectorSchemaTableResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schemaResponse, err := r.GetClient().NewConnectionSchemaDetails().ConnectionID(data.ConnectionId.ValueString()).Do(ctx)
+	if err != nil {
+		if schemaResponse.Code == "NotFound_SchemaConfig" || schemaResponse.Code == "NotFound_Connector" || schemaResponse.Code == "NotFound_Connection" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to Read Connector Schema Table Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, schemaResponse.Code, schemaResponse.Message),
+		)
+		return
+	}
+
+	if !data.ReadFromResponse(schemaResponse) {
+		// the table was removed from the source
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *connectorSchemaTable) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var plan model.ConnectorSchemaTableResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applyTableConfig(ctx, &plan, "Unable to Update Connector Schema Table Resource.", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *connectorSchemaTable) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Nothing to do: the table config is retained, same as for `fivetran_connector_schema_config`
+}
+
+// applyTableConfig patches the table through the per-table endpoint and re-reads it,
+// the patch response contains only applied diffs, not the whole table configuration.
+func (r *connectorSchemaTable) applyTableConfig(ctx context.Context, data *model.ConnectorSchemaTableResourceModel, summary string, diags *diag.Diagnostics) {
+	client := r.GetClient()
+	connectionId := data.ConnectionId.ValueString()
+
+	svc := data.PrepareRequest(client.NewConnectionTableConfigUpdateService())
+	// columns fetched for schema config validation are outdated once the table config is changed
+	if fetcher := r.GetColumnFetcher(); fetcher != nil {
+		fetcher.Invalidate(connectionId)
+	}
+	if response, err := svc.Do(ctx); err != nil {
+		diags.AddError(
+			summary,
+			fmt.Sprintf("Error while applying table config patch. %v; code: %v; message: %v", err, response.Code, response.Message),
+		)
+		return
+	}
+
+	schemaResponse, err := client.NewConnectionSchemaDetails().ConnectionID(connectionId).Do(ctx)
+	if err != nil {
+		diags.AddError(
+			summary,
+			fmt.Sprintf("Error while reading schema config. %v; code: %v; message: %v", err, schemaResponse.Code, schemaResponse.Message),
+		)
+		return
+	}
+
+	if !data.ReadFromResponse(schemaResponse) {
+		diags.AddError(
+			summary,
+			fmt.Sprintf("Table `%v` of schema `%v` not found in the schema config of connection %v.", data.Table.ValueString(), data.Schema.ValueString(), connectionId),
+		)
+		return
+	}
+}
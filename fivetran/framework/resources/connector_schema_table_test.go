@@ -0,0 +1,210 @@
+package resources
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/fivetran/go-fivetran"
+	httputils "github.com/fivetran/go-fivetran/http_utils"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	configSchema "github.com/fivetran/terraform-provider-fivetran/modules/connector/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func connectorSchemaTableResource(t *testing.T, httpClient httputils.HttpClient) (*connectorSchemaTable, schema.Schema) {
+	t.Helper()
+	client := fivetran.New("key", "secret")
+	client.SetHttpClient(httpClient)
+
+	r := &connectorSchemaTable{}
+	configureProviderResource(t, &r.ProviderResource, client, &sync.Map{}, false)
+	var resp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	return r, resp.Schema
+}
+
+// schemaTablePlan builds a plan where computed attributes that are not given are unknown and columns are null.
+func schemaTablePlan(t *testing.T, s schema.Schema, values map[string]tftypes.Value) tfsdk.Plan {
+	t.Helper()
+	objectType := s.Type().TerraformType(context.Background()).(tftypes.Object)
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		if value, ok := values[name]; ok {
+			attributes[name] = value
+		} else if name == "columns" {
+			attributes[name] = tftypes.NewValue(attributeType, nil)
+		} else {
+			attributes[name] = tftypes.NewValue(attributeType, tftypes.UnknownValue)
+		}
+	}
+	return tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(objectType, attributes)}
+}
+
+func TestConnectorSchemaTableCreate(t *testing.T) {
+	t.Parallel()
+
+	httpClient := &schemaPatchHTTPClient{details: `{"code":"Success","data":{
+		"schema_change_handling":"ALLOW_ALL",
+		"schemas":{"public":{"enabled":true,"tables":{"users":{"enabled":true,"sync_mode":"HISTORY","columns":{
+			"email":{"enabled":true,"hashed":true},
+			"id":{"enabled":true,"hashed":false,"is_primary_key":true}
+		}}}}}}}`}
+	r, s := connectorSchemaTableResource(t, httpClient)
+
+	columnsType := s.Type().TerraformType(context.Background()).(tftypes.Object).AttributeTypes["columns"].(tftypes.Map)
+	columnType := columnsType.ElementType.(tftypes.Object)
+	plan := schemaTablePlan(t, s, map[string]tftypes.Value{
+		"connection_id": tftypes.NewValue(tftypes.String, "connection_id"),
+		"schema":        tftypes.NewValue(tftypes.String, "public"),
+		"table":         tftypes.NewValue(tftypes.String, "users"),
+		"sync_mode":     tftypes.NewValue(tftypes.String, "HISTORY"),
+		"columns": tftypes.NewValue(columnsType, map[string]tftypes.Value{
+			"email": tftypes.NewValue(columnType, map[string]tftypes.Value{
+				"enabled":        tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+				"hashed":         tftypes.NewValue(tftypes.Bool, true),
+				"is_primary_key": tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+			}),
+		}),
+	})
+
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}}
+	r.Create(context.Background(), resource.CreateRequest{Plan: plan}, &resp)
+	assertNoDiagnostics(t, resp.Diagnostics)
+
+	if len(httpClient.patches) != 1 {
+		t.Fatalf("patch requests = %v, want 1", len(httpClient.patches))
+	}
+	patch := httpClient.patches[0]
+	if _, ok := patch["enabled"]; ok || patch["sync_mode"] != "HISTORY" {
+		t.Errorf("unexpected table patch: %v", patch)
+	}
+	email := patch["columns"].(map[string]interface{})["email"].(map[string]interface{})
+	if _, ok := email["enabled"]; ok || email["hashed"] != true {
+		t.Errorf("unexpected column patch: %v", email)
+	}
+
+	var state model.ConnectorSchemaTableResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+	assertNoDiagnostics(t, resp.Diagnostics)
+	if state.Id.ValueString() != "connection_id/public/users" || !state.Enabled.ValueBool() {
+		t.Errorf("unexpected state: %v", state)
+	}
+	if len(state.Columns.Elements()) != 1 {
+		t.Errorf("only configured columns should be kept in state: %v", state.Columns)
+	}
+	emailState := state.Columns.Elements()["email"].(types.Object).Attributes()
+	if !emailState["enabled"].(types.Bool).ValueBool() || !emailState["is_primary_key"].(types.Bool).IsNull() {
+		t.Errorf("unexpected column state: %v", emailState)
+	}
+}
+
+func TestConnectorSchemaTableImportState(t *testing.T) {
+	t.Parallel()
+
+	r, s := connectorSchemaTableResource(t, errorHTTPClient{})
+	resp := resource.ImportStateResponse{State: nullState(context.Background(), s)}
+	r.ImportState(context.Background(), resource.ImportStateRequest{ID: "connection_id/public/users"}, &resp)
+	assertNoDiagnostics(t, resp.Diagnostics)
+
+	var state model.ConnectorSchemaTableResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+	if state.ConnectionId.ValueString() != "connection_id" || state.Schema.ValueString() != "public" || state.Table.ValueString() != "users" {
+		t.Errorf("unexpected state: %v", state)
+	}
+
+	resp = resource.ImportStateResponse{State: nullState(context.Background(), s)}
+	r.ImportState(context.Background(), resource.ImportStateRequest{ID: "connection_id/public/events/2024"}, &resp)
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+	assertNoDiagnostics(t, resp.Diagnostics)
+	if state.Schema.ValueString() != "public" || state.Table.ValueString() != "events/2024" {
+		t.Errorf("unexpected state of table with `/` in name: %v", state)
+	}
+
+	resp = resource.ImportStateResponse{State: nullState(context.Background(), s)}
+	r.ImportState(context.Background(), resource.ImportStateRequest{ID: "connection_id"}, &resp)
+	assertErrorCount(t, resp.Diagnostics, 1)
+}
+
+func TestConnectorSchemaTableSchemaNameValidation(t *testing.T) {
+	t.Parallel()
+
+	_, s := connectorSchemaTableResource(t, errorHTTPClient{})
+	schemaAttribute := s.Attributes["schema"].(schema.StringAttribute)
+
+	for name, wantErrors := range map[string]int{"public": 0, "sales/eu": 1} {
+		var resp validator.StringResponse
+		for _, v := range schemaAttribute.Validators {
+			v.ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("schema"),
+				ConfigValue: types.StringValue(name),
+			}, &resp)
+		}
+		if resp.Diagnostics.ErrorsCount() != wantErrors {
+			t.Errorf("schema %q: %d errors, want %d: %v", name, resp.Diagnostics.ErrorsCount(), wantErrors, resp.Diagnostics)
+		}
+	}
+}
+
+func TestConnectorSchemaTableConflictsWithSchemaConfig(t *testing.T) {
+	t.Parallel()
+
+	ownership := configSchema.NewTableOwnership()
+	tableResource, s := connectorSchemaTableResource(t, errorHTTPClient{})
+	configureTableOwnership(t, &tableResource.ProviderResource, ownership)
+
+	for _, table := range []string{"users", "orders"} {
+		plan := schemaTablePlan(t, s, map[string]tftypes.Value{
+			"connection_id": tftypes.NewValue(tftypes.String, "connection_id"),
+			"schema":        tftypes.NewValue(tftypes.String, "public"),
+			"table":         tftypes.NewValue(tftypes.String, table),
+		})
+		resp := resource.ModifyPlanResponse{Plan: plan}
+		tableResource.ModifyPlan(context.Background(), resource.ModifyPlanRequest{Plan: plan}, &resp)
+		assertNoDiagnostics(t, resp.Diagnostics)
+	}
+
+	schemaResource := &connectorSchema{}
+	configureTableOwnership(t, &schemaResource.ProviderResource, ownership)
+	schemaConfigSchema := connectorSchemaResourceSchema(t, schemaResource)
+	config := configWithValues(t, schemaConfigSchema, map[string]tftypes.Value{
+		"connector_id":     tftypes.NewValue(tftypes.String, "connection_id"),
+		"validation_level": tftypes.NewValue(tftypes.String, "NONE"),
+		"schemas_json":     tftypes.NewValue(tftypes.String, `{"public":{"tables":{"users":{"enabled":false}}}}`),
+	}, nil)
+	req := resource.ModifyPlanRequest{
+		Config: config,
+		Plan:   tfsdk.Plan{Raw: config.Raw, Schema: schemaConfigSchema},
+		State:  tfsdk.State{Raw: tftypes.NewValue(config.Raw.Type(), nil), Schema: schemaConfigSchema},
+	}
+	resp := resource.ModifyPlanResponse{Plan: req.Plan}
+	schemaResource.ModifyPlan(context.Background(), req, &resp)
+
+	assertErrorCount(t, resp.Diagnostics, 1)
+	assertWarningCount(t, resp.Diagnostics, 1)
+}
+
+func configureTableOwnership(t *testing.T, r *core.ProviderResource, ownership *configSchema.TableOwnership) {
+	t.Helper()
+	client := fivetran.New("key", "secret")
+	client.SetHttpClient(errorHTTPClient{})
+
+	var resp resource.ConfigureResponse
+	r.Configure(context.Background(), resource.ConfigureRequest{
+		ProviderData: &core.ProviderResourceData{
+			Client:         client,
+			MetadataCache:  &sync.Map{},
+			TableOwnership: ownership,
+		},
+	}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("configure diagnostics: %v", resp.Diagnostics)
+	}
+}
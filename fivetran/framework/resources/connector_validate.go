@@ -0,0 +1,137 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fivetran/go-fivetran/metadata"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.ResourceWithValidateConfig = &connector{}
+
+func (r *connector) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if r.GetSkipPlanTimeValidation() {
+		return
+	}
+
+	var data model.ConnectorResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Service.IsNull() || data.Service.IsUnknown() || data.Service.ValueString() == "" {
+		return
+	}
+
+	// Static schema errors (unknown service, service specific field names) are reported on apply.
+	configMap, err := data.GetValidationConfigMap()
+	if err != nil {
+		return
+	}
+	authMap, err := data.GetValidationAuthMap()
+	if err != nil {
+		return
+	}
+
+	validateLegacyConfigAgainstMetadata(ctx, &r.ProviderResource, data.Service.ValueString(), configMap, authMap, &resp.Diagnostics)
+}
+
+// validateLegacyConfigAgainstMetadata validates config/auth maps built by resources with a static
+// or JSON config representation. Unlike fivetran_connection_v2 it never fails on metadata access
+// problems or fields missing in metadata: these resources accepted such configurations before.
+func validateLegacyConfigAgainstMetadata(ctx context.Context, p *core.ProviderResource, service string, configMap, authMap map[string]interface{}, diags *diag.Diagnostics) {
+	if len(configMap) == 0 && len(authMap) == 0 {
+		return
+	}
+
+	if p.GetClient() == nil {
+		// Provider is not configured yet (e.g. `terraform validate`), use cached metadata only.
+		if _, ok, _ := core.LoadCachedConnectorMetadata(p.GetMetadataCache(), service); !ok {
+			return
+		}
+	}
+
+	meta, err := providerConnectorMetadata(ctx, p, service)
+	if err != nil {
+		diags.AddWarning(
+			"Unable to Validate Connector Configuration",
+			fmt.Sprintf("Unable to fetch metadata for service %q, config and auth fields were not validated. Original error: %v", service, err),
+		)
+		return
+	}
+
+	validateLegacyDynamicObject(configMap, &meta.Config, path.Root("config"), p.GetFieldStatusPolicy(), diags)
+	validateLegacyDynamicObject(authMap, &meta.Auth, path.Root("auth"), p.GetFieldStatusPolicy(), diags)
+}
+
+func validateLegacyDynamicObject(values map[string]interface{}, slot *metadata.Property, root path.Path, fieldStatusPolicy string, diags *diag.Diagnostics) {
+	if values == nil {
+		return
+	}
+	validateDynamicObject(normalizeLegacyDynamicObject(values, slot, root, diags), slot, root, fieldStatusPolicy, diags)
+}
+
+// normalizeLegacyDynamicObject drops fields unknown to metadata (with a warning) and converts
+// scalar values to the metadata scalar type where possible: the static connector schema keeps
+// many numeric and boolean fields as strings.
+func normalizeLegacyDynamicObject(values map[string]interface{}, slot *metadata.Property, root path.Path, diags *diag.Diagnostics) map[string]interface{} {
+	result := make(map[string]interface{}, len(values))
+	for name, value := range values {
+		prop := core.SlotProp(slot, name)
+		if prop == nil {
+			diags.AddAttributeWarning(
+				root.AtName(name),
+				"Unrecognized Config Field",
+				fmt.Sprintf("Metadata for this service does not define field %q. The value will be sent to the API as is.", name),
+			)
+			continue
+		}
+		result[name] = normalizeLegacyDynamicValue(value, prop, root.AtName(name), diags)
+	}
+	return result
+}
+
+func normalizeLegacyDynamicValue(value interface{}, prop *metadata.Property, valuePath path.Path, diags *diag.Diagnostics) interface{} {
+	switch v := value.(type) {
+	case string:
+		switch prop.Type {
+		case "integer":
+			if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+				return i
+			}
+		case "number":
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				return f
+			}
+		case "boolean":
+			if b, err := strconv.ParseBool(v); err == nil {
+				return b
+			}
+		}
+	case bool, int64, float64:
+		// The API accepts scalars for string fields, e.g. `port = 5432` where metadata declares a string.
+		if prop.Type == "string" && len(prop.Enum) == 0 {
+			return fmt.Sprintf("%v", v)
+		}
+	case map[string]interface{}:
+		if prop.Type == "object" {
+			return normalizeLegacyDynamicObject(v, prop, valuePath, diags)
+		}
+	case []interface{}:
+		if prop.Type == "array" && prop.Items != nil {
+			result := make([]interface{}, len(v))
+			for i, item := range v {
+				result[i] = normalizeLegacyDynamicValue(item, prop.Items, valuePath.AtListIndex(i), diags)
+			}
+			return result
+		}
+	}
+	return value
+}
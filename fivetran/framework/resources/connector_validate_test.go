@@ -0,0 +1,338 @@
+package resources
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	fivetran "github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/metadata"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/common"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNormalizeLegacyDynamicObjectCoercesStrings(t *testing.T) {
+	t.Parallel()
+
+	var diags diag.Diagnostics
+	validateLegacyDynamicObject(map[string]interface{}{
+		"port":    "5432",
+		"ratio":   "0.5",
+		"enabled": "true",
+		"nested":  map[string]interface{}{"limit": "10"},
+		"items":   []interface{}{"1", "2"},
+	}, &metadata.Property{Properties: map[string]*metadata.Property{
+		"port":    {Type: "integer"},
+		"ratio":   {Type: "number"},
+		"enabled": {Type: "boolean"},
+		"nested": {
+			Type:       "object",
+			Properties: map[string]*metadata.Property{"limit": {Type: "integer"}},
+		},
+		"items": {Type: "array", Items: &metadata.Property{Type: "integer"}},
+	}}, path.Root("config"), core.FieldStatusPolicyWarn, &diags)
+
+	assertNoDiagnostics(t, diags)
+}
+
+func TestNormalizeLegacyDynamicObjectReportsInvalidValues(t *testing.T) {
+	t.Parallel()
+
+	var diags diag.Diagnostics
+	validateLegacyDynamicObject(map[string]interface{}{
+		"port":          "not_a_number",
+		"update_method": "BAD",
+		"legacy_field":  "value",
+	}, &metadata.Property{Properties: map[string]*metadata.Property{
+		"port":          {Type: "integer"},
+		"update_method": {Type: "string", Enum: []string{"XMIN", "WAL"}},
+	}}, path.Root("config"), core.FieldStatusPolicyWarn, &diags)
+
+	assertErrorCount(t, diags, 2)
+	assertWarningCount(t, diags, 1)
+}
+
+func TestConnectorValidateConfigUsesMetadataCache(t *testing.T) {
+	common.LoadConfigFieldsMap()
+	common.LoadAuthFieldsMap()
+
+	cache := &sync.Map{}
+	cache.Store("postgres", &metadata.ConnectorMetadata{
+		Config: metadata.Property{Properties: map[string]*metadata.Property{
+			"host":          {Type: "string"},
+			"port":          {Type: "integer"},
+			"update_method": {Type: "string", Enum: []string{"XMIN", "WAL"}, FieldStatus: core.FieldStatusSunset},
+		}},
+	})
+
+	r := &connector{}
+	configureProviderResource(t, &r.ProviderResource, nil, cache, false)
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+	req := resource.ValidateConfigRequest{Config: configWithValues(t, schemaResp.Schema, map[string]tftypes.Value{
+		"service": tftypes.NewValue(tftypes.String, "postgres"),
+	}, map[string]map[string]tftypes.Value{
+		"config": {
+			"host":          tftypes.NewValue(tftypes.String, "db.example.com"),
+			"port":          tftypes.NewValue(tftypes.Number, 5432),
+			"update_method": tftypes.NewValue(tftypes.String, "QUERY_BASED"),
+		},
+	})}
+
+	var resp resource.ValidateConfigResponse
+	r.ValidateConfig(context.Background(), req, &resp)
+
+	assertErrorCount(t, resp.Diagnostics, 1)
+	assertWarningCount(t, resp.Diagnostics, 1)
+
+	skipped := &connector{}
+	configureProviderResource(t, &skipped.ProviderResource, nil, cache, true)
+	resp = resource.ValidateConfigResponse{}
+	skipped.ValidateConfig(context.Background(), req, &resp)
+	assertNoDiagnostics(t, resp.Diagnostics)
+}
+
+func TestConnectorValidateConfigAppliesFieldStatusPolicy(t *testing.T) {
+	common.LoadConfigFieldsMap()
+	common.LoadAuthFieldsMap()
+
+	cache := &sync.Map{}
+	cache.Store("postgres", &metadata.ConnectorMetadata{
+		Config: metadata.Property{Properties: map[string]*metadata.Property{
+			"host": {Type: "string", FieldStatus: core.FieldStatusPrivatePreview},
+		}},
+	})
+
+	r := &connector{}
+	var configureResp resource.ConfigureResponse
+	r.Configure(context.Background(), resource.ConfigureRequest{
+		ProviderData: &core.ProviderResourceData{
+			MetadataCache:     cache,
+			FieldStatusPolicy: core.FieldStatusPolicyError,
+		},
+	}, &configureResp)
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+	req := resource.ValidateConfigRequest{Config: configWithValues(t, schemaResp.Schema, map[string]tftypes.Value{
+		"service": tftypes.NewValue(tftypes.String, "postgres"),
+	}, map[string]map[string]tftypes.Value{
+		"config": {"host": tftypes.NewValue(tftypes.String, "db.example.com")},
+	})}
+
+	var resp resource.ValidateConfigResponse
+	r.ValidateConfig(context.Background(), req, &resp)
+
+	assertErrorCount(t, resp.Diagnostics, 1)
+	assertWarningCount(t, resp.Diagnostics, 0)
+}
+
+func TestConnectorValidateConfigReportsUnparsedNumericStrings(t *testing.T) {
+	common.LoadConfigFieldsMap()
+	common.LoadAuthFieldsMap()
+
+	// api_usage is a string field in the connector schema, sent to the API as a number for zendesk.
+	cache := &sync.Map{}
+	cache.Store("zendesk", &metadata.ConnectorMetadata{
+		Config: metadata.Property{Properties: map[string]*metadata.Property{
+			"api_usage": {Type: "number"},
+		}},
+	})
+
+	r := &connector{}
+	configureProviderResource(t, &r.ProviderResource, nil, cache, false)
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+	for value, wantErrors := range map[string]int{"0.5": 0, "half": 1} {
+		req := resource.ValidateConfigRequest{Config: configWithValues(t, schemaResp.Schema, map[string]tftypes.Value{
+			"service": tftypes.NewValue(tftypes.String, "zendesk"),
+		}, map[string]map[string]tftypes.Value{
+			"config": {"api_usage": tftypes.NewValue(tftypes.String, value)},
+		})}
+
+		var resp resource.ValidateConfigResponse
+		r.ValidateConfig(context.Background(), req, &resp)
+		if resp.Diagnostics.ErrorsCount() != wantErrors || resp.Diagnostics.WarningsCount() != 0 {
+			t.Errorf("api_usage %q: unexpected diagnostics: %v", value, resp.Diagnostics)
+		}
+	}
+}
+
+func TestConnectorValidateConfigWithoutMetadataIsSilent(t *testing.T) {
+	common.LoadConfigFieldsMap()
+	common.LoadAuthFieldsMap()
+
+	r := &connector{}
+	configureProviderResource(t, &r.ProviderResource, nil, &sync.Map{}, false)
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+	req := resource.ValidateConfigRequest{Config: configWithValues(t, schemaResp.Schema, map[string]tftypes.Value{
+		"service": tftypes.NewValue(tftypes.String, "postgres"),
+	}, map[string]map[string]tftypes.Value{
+		"config": {"host": tftypes.NewValue(tftypes.String, "db.example.com")},
+	})}
+
+	var resp resource.ValidateConfigResponse
+	r.ValidateConfig(context.Background(), req, &resp)
+	assertNoDiagnostics(t, resp.Diagnostics)
+
+	client := fivetran.New("key", "secret")
+	client.SetHttpClient(errorHTTPClient{})
+	configureProviderResource(t, &r.ProviderResource, client, &sync.Map{}, false)
+
+	resp = resource.ValidateConfigResponse{}
+	r.ValidateConfig(context.Background(), req, &resp)
+	assertErrorCount(t, resp.Diagnostics, 0)
+	assertWarningCount(t, resp.Diagnostics, 1)
+}
+
+func TestConnectionConfigValidateConfigUsesConnectionService(t *testing.T) {
+	t.Parallel()
+
+	cache := &sync.Map{}
+	cache.Store("postgres", &metadata.ConnectorMetadata{
+		Config: metadata.Property{Properties: map[string]*metadata.Property{
+			"port": {Type: "integer"},
+		}},
+		Auth: metadata.Property{Properties: map[string]*metadata.Property{
+			"password": {Type: "string", Format: "password"},
+		}},
+	})
+
+	client := fivetran.New("key", "secret")
+	client.SetHttpClient(staticHTTPClient{body: `{"code":"Success","data":{"id":"connection_id","service":"postgres"}}`})
+
+	r := &connectionConfig{}
+	configureProviderResource(t, &r.ProviderResource, client, cache, false)
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+	req := resource.ValidateConfigRequest{Config: configWithValues(t, schemaResp.Schema, map[string]tftypes.Value{
+		"connection_id": tftypes.NewValue(tftypes.String, "connection_id"),
+		"config":        tftypes.NewValue(tftypes.String, `{"port": "abc", "unknown": 1}`),
+		"auth":          tftypes.NewValue(tftypes.String, `{"password": ["secret"]}`),
+	}, nil)}
+
+	var resp resource.ValidateConfigResponse
+	r.ValidateConfig(context.Background(), req, &resp)
+
+	assertErrorCount(t, resp.Diagnostics, 2)
+	assertWarningCount(t, resp.Diagnostics, 1)
+}
+
+func TestConnectionConfigValidateConfigCachesConnectionService(t *testing.T) {
+	t.Parallel()
+
+	cache := &sync.Map{}
+	cache.Store("postgres", &metadata.ConnectorMetadata{
+		Config: metadata.Property{Properties: map[string]*metadata.Property{
+			"port": {Type: "integer"},
+		}},
+	})
+
+	httpClient := &requestRecordingHTTPClient{routes: map[string]string{
+		"GET /v1/connections/connection_id": `{"code":"Success","data":{"id":"connection_id","service":"postgres"}}`,
+	}}
+	client := fivetran.New("key", "secret")
+	client.SetHttpClient(httpClient)
+
+	r := &connectionConfig{}
+	configureProviderResource(t, &r.ProviderResource, client, cache, false)
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+	req := resource.ValidateConfigRequest{Config: configWithValues(t, schemaResp.Schema, map[string]tftypes.Value{
+		"connection_id": tftypes.NewValue(tftypes.String, "connection_id"),
+		"config":        tftypes.NewValue(tftypes.String, `{"port": "abc"}`),
+	}, nil)}
+
+	for i := 0; i < 2; i++ {
+		var resp resource.ValidateConfigResponse
+		r.ValidateConfig(context.Background(), req, &resp)
+		assertErrorCount(t, resp.Diagnostics, 1)
+	}
+
+	if len(httpClient.requests) != 1 {
+		t.Errorf("connection details requests = %v, want one", httpClient.requests)
+	}
+}
+
+func configureProviderResource(t *testing.T, r *core.ProviderResource, client *fivetran.Client, cache *sync.Map, skip bool) {
+	t.Helper()
+
+	var resp resource.ConfigureResponse
+	r.Configure(context.Background(), resource.ConfigureRequest{
+		ProviderData: &core.ProviderResourceData{
+			Client:                 client,
+			MetadataCache:          cache,
+			SkipPlanTimeValidation: skip,
+		},
+	}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("configure diagnostics: %v", resp.Diagnostics)
+	}
+}
+
+// configWithValues builds a config where every attribute is null except the given top level
+// values and the given attributes of top level objects.
+func configWithValues(t *testing.T, s schema.Schema, values map[string]tftypes.Value, objects map[string]map[string]tftypes.Value) tfsdk.Config {
+	t.Helper()
+
+	objectType := s.Type().TerraformType(context.Background()).(tftypes.Object)
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		if value, ok := values[name]; ok {
+			attributes[name] = value
+			continue
+		}
+		if nested, ok := objects[name]; ok {
+			nestedType := attributeType.(tftypes.Object)
+			nestedValues := make(map[string]tftypes.Value, len(nestedType.AttributeTypes))
+			for nestedName, nestedAttributeType := range nestedType.AttributeTypes {
+				if value, ok := nested[nestedName]; ok {
+					nestedValues[nestedName] = value
+				} else {
+					nestedValues[nestedName] = tftypes.NewValue(nestedAttributeType, nil)
+				}
+			}
+			attributes[name] = tftypes.NewValue(nestedType, nestedValues)
+			continue
+		}
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	return tfsdk.Config{
+		Raw:    tftypes.NewValue(objectType, attributes),
+		Schema: s,
+	}
+}
+
+type staticHTTPClient struct {
+	body string
+}
+
+func (c staticHTTPClient) Do(*http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(c.body)),
+	}, nil
+}
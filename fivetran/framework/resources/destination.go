@@ -8,7 +8,6 @@ import (
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
 	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -25,6 +24,7 @@ type destination struct {
 // Ensure the implementation satisfies the desired interfaces.
 var _ resource.ResourceWithConfigure = &destination{}
 var _ resource.ResourceWithImportState = &destination{}
+var _ resource.ResourceWithIdentity = &destination{}
 var _ resource.ResourceWithUpgradeState = &destination{}
 
 func (r *destination) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -39,6 +39,10 @@ func (r *destination) Schema(ctx context.Context, req resource.SchemaRequest, re
 	}
 }
 
+func (r *destination) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = fivetranSchema.IdIdentitySchema("The unique identifier for the destination within the Fivetran system.")
+}
+
 func (r *destination) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	return map[int64]resource.StateUpgrader{
 		// State upgrade implementation from 0 (prior state version) to 2 (Schema.Version)
@@ -57,7 +61,8 @@ func (r *destination) UpgradeState(ctx context.Context) map[int64]resource.State
 }
 
 func (r *destination) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id, err := resolveImportId(ctx, r.GetClient(), req, resolveGroupImportId)
+	importResolvedId(ctx, resp, "Unable to Import Destination Resource.", id, err, "id")
 }
 
 func (r *destination) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -93,6 +98,8 @@ func (r *destination) Create(ctx context.Context, req resource.CreateRequest, re
 	trustCertificatesPlan := core.GetBoolOrDefault(data.TrustCertificates, false)
 	trustFingerprintsPlan := core.GetBoolOrDefault(data.TrustFingerprints, false)
 	daylightSavingTimeEnabledPlan := core.GetBoolOrDefault(data.DaylightSavingTimeEnabled, false)
+	failOnSetupTestFailurePlan := core.GetBoolOrDefault(data.FailOnSetupTestFailure, r.GetFailOnSetupTestFailure())
+	rollbackOnFailurePlan := core.GetBoolOrDefault(data.RollbackOnFailure, false)
 
 	svc := r.GetClient().NewDestinationCreate().
 		Service(data.Service.ValueString()).
@@ -160,16 +167,7 @@ func (r *destination) Create(ctx context.Context, req resource.CreateRequest, re
 		}
 
 		if strings.ToLower(stResponse.Data.SetupStatus) != "connected" {
-			if stResponse.Data.SetupTests != nil && len(stResponse.Data.SetupTests) > 0 {
-				for _, tr := range stResponse.Data.SetupTests {
-					if tr.Status != "PASSED" && tr.Status != "SKIPPED" {
-						resp.Diagnostics.AddWarning(
-							fmt.Sprintf("Destination setup test `%v` has status `%v`", tr.Title, tr.Status),
-							tr.Message,
-						)
-					}
-				}
-			}
+			reportSetupTests(stResponse.Data.SetupTests, "Destination", failOnSetupTestFailurePlan, &resp.Diagnostics)
 		}
 
 		detailsResponse, err := r.GetClient().NewDestinationDetails().DestinationID(response.Data.ID).DoCustom(ctx)
@@ -191,8 +189,16 @@ func (r *destination) Create(ctx context.Context, req resource.CreateRequest, re
 	data.RunSetupTests = types.BoolValue(runSetupTestsPlan)
 	data.TrustCertificates = types.BoolValue(trustCertificatesPlan)
 	data.TrustFingerprints = types.BoolValue(trustFingerprintsPlan)
+	data.FailOnSetupTestFailure = types.BoolValue(failOnSetupTestFailurePlan)
+	data.RollbackOnFailure = types.BoolValue(rollbackOnFailurePlan)
+
+	// without rollback the destination is saved to state even if setup tests fail, so it's marked as tainted instead of being lost
+	if resp.Diagnostics.HasError() && rollbackOnFailurePlan && rollbackCreatedDestination(ctx, r.GetClient(), data.Id.ValueString(), &resp.Diagnostics) {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentity(data.Id))...)
 }
 
 func (r *destination) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -251,6 +257,7 @@ func (r *destination) Read(ctx context.Context, req resource.ReadRequest, resp *
 	data.TrustFingerprints = trustFingerprints
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentity(data.Id))...)
 
 }
 
@@ -278,6 +285,7 @@ func (r *destination) Update(ctx context.Context, req resource.UpdateRequest, re
 	trustCertificatesPlan := core.GetBoolOrDefault(plan.TrustCertificates, false)
 	trustFingerprintsPlan := core.GetBoolOrDefault(plan.TrustFingerprints, false)
 	daylightSavingTimeEnabledPlan := core.GetBoolOrDefault(plan.DaylightSavingTimeEnabled, false)
+	failOnSetupTestFailurePlan := core.GetBoolOrDefault(plan.FailOnSetupTestFailure, r.GetFailOnSetupTestFailure())
 
 	runSetupTestsState := core.GetBoolOrDefault(state.RunSetupTests, false)
 	trustCertificatesState := core.GetBoolOrDefault(state.TrustCertificates, false)
@@ -350,15 +358,8 @@ func (r *destination) Update(ctx context.Context, req resource.UpdateRequest, re
 		plan.TrustCertificates = types.BoolValue(trustCertificatesPlan)
 		plan.TrustFingerprints = types.BoolValue(trustFingerprintsPlan)
 
-		if runSetupTestsPlan && response.Data.SetupTests != nil && len(response.Data.SetupTests) > 0 {
-			for _, tr := range response.Data.SetupTests {
-				if tr.Status != "PASSED" && tr.Status != "SKIPPED" {
-					resp.Diagnostics.AddWarning(
-						fmt.Sprintf("Destination setup test `%v` has status `%v`", tr.Title, tr.Status),
-						tr.Message,
-					)
-				}
-			}
+		if runSetupTestsPlan {
+			reportSetupTests(response.Data.SetupTests, "Destination", failOnSetupTestFailurePlan, &resp.Diagnostics)
 		}
 	} else {
 		// If values of testing fields changed we should run tests
@@ -375,16 +376,7 @@ func (r *destination) Update(ctx context.Context, req resource.UpdateRequest, re
 				return
 			}
 
-			if response.Data.SetupTests != nil && len(response.Data.SetupTests) > 0 {
-				for _, tr := range response.Data.SetupTests {
-					if tr.Status != "PASSED" && tr.Status != "SKIPPED" {
-						resp.Diagnostics.AddWarning(
-							fmt.Sprintf("Destination setup test `%v` has status `%v`", tr.Title, tr.Status),
-							tr.Message,
-						)
-					}
-				}
-			}
+			reportSetupTests(response.Data.SetupTests, "Destination", failOnSetupTestFailurePlan, &resp.Diagnostics)
 		}
 	}
 
@@ -419,8 +411,11 @@ func (r *destination) Update(ctx context.Context, req resource.UpdateRequest, re
 	if plan.TrustFingerprints.IsUnknown() {
 		plan.TrustFingerprints = state.TrustFingerprints
 	}
+	plan.FailOnSetupTestFailure = types.BoolValue(failOnSetupTestFailurePlan)
+	plan.RollbackOnFailure = types.BoolValue(core.GetBoolOrDefault(plan.RollbackOnFailure, false))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentity(plan.Id))...)
 }
 
 func configuredStringValue(value types.String) (string, bool) {
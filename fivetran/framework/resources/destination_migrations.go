@@ -66,6 +66,8 @@ func upgradeDestinationState(ctx context.Context, req resource.UpgradeStateReque
 			"run_setup_tests":    convertStringStateValueToBool("run_setup_tests", rawState["run_setup_tests"], resp.Diagnostics),
 			"trust_fingerprints": convertStringStateValueToBool("trust_fingerprints", rawState["trust_fingerprints"], resp.Diagnostics),
 			"trust_certificates": convertStringStateValueToBool("trust_certificates", rawState["trust_certificates"], resp.Diagnostics),
+			"fail_on_setup_test_failure":   tftypes.NewValue(tftypes.Bool, nil),
+			"rollback_on_failure":          tftypes.NewValue(tftypes.Bool, nil),
 			"config": config,
 		}),
 	)
@@ -97,6 +99,8 @@ func getDestinationStateModel(version int) tftypes.Type {
 		base["hybrid_deployment_agent_id"] = tftypes.String
 		base["networking_method"] = tftypes.String
 		base["private_link_id"] = tftypes.String
+		base["fail_on_setup_test_failure"] = tftypes.Bool
+		base["rollback_on_failure"] = tftypes.Bool
 
 		base["config"] = tftypes.Object{AttributeTypes: model.GetTfTypesDestination(common.GetDestinationFieldsMap(), 1)}
 	} else if version == 1 {
@@ -0,0 +1,334 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fivetran/go-fivetran/destinations"
+	"github.com/fivetran/go-fivetran/metadata"
+	fivetranCommon "github.com/fivetran/terraform-provider-fivetran/fivetran/common"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func DestinationV2() resource.Resource {
+	return &destinationV2{}
+}
+
+type destinationV2 struct {
+	core.ProviderResource
+}
+
+var _ resource.ResourceWithConfigure = &destinationV2{}
+var _ resource.ResourceWithImportState = &destinationV2{}
+
+func (r *destinationV2) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_destination_v2"
+}
+
+func (r *destinationV2) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = fivetranSchema.DestinationV2ResourceSchema()
+}
+
+func (r *destinationV2) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	details, err := r.GetClient().NewDestinationDetails().DestinationID(req.ID).DoCustom(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Import Destination V2 Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, details.Code, details.Message),
+		)
+		return
+	}
+
+	data := model.DestinationV2ResourceModel{
+		RunSetupTests:          types.BoolValue(false),
+		TrustCertificates:      types.BoolValue(false),
+		TrustFingerprints:      types.BoolValue(false),
+		FailOnSetupTestFailure: types.BoolNull(),
+		RollbackOnFailure:      types.BoolNull(),
+	}
+
+	resp.Diagnostics.Append(data.ReadFromResponseForImport(ctx, details, r.destinationMetadata(details.Data.Service))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"fivetran_destination_v2 import requires HCL review",
+		"Terraform imported the destination using API-visible config fields. Add the matching fivetran_destination_v2 resource block to your configuration and restore any sensitive config values because the API does not return secrets.",
+	)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *destinationV2) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	var data model.DestinationV2ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configMap, diags := core.DynamicToMap(ctx, data.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	runSetupTestsPlan := core.GetBoolOrDefault(data.RunSetupTests, false)
+	trustCertificatesPlan := core.GetBoolOrDefault(data.TrustCertificates, false)
+	trustFingerprintsPlan := core.GetBoolOrDefault(data.TrustFingerprints, false)
+	failOnSetupTestFailurePlan := core.GetBoolOrDefault(data.FailOnSetupTestFailure, r.GetFailOnSetupTestFailure())
+
+	svc := r.GetClient().NewDestinationCreate().
+		Service(data.Service.ValueString()).
+		GroupID(data.GroupId.ValueString()).
+		TimeZoneOffset(data.TimeZoneOffset.ValueString()).
+		RunSetupTests(runSetupTestsPlan).
+		TrustCertificates(trustCertificatesPlan).
+		TrustFingerprints(trustFingerprintsPlan)
+
+	if configMap != nil {
+		svc.ConfigCustom(&configMap)
+	}
+
+	r.applyCreateRootFields(svc, data)
+
+	response, err := svc.DoCustom(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Destination V2 Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, response.Code, response.Message),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.ReadFromCreateResponse(ctx, response, r.destinationMetadata(data.Service.ValueString()), configMap)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.RunSetupTests = types.BoolValue(runSetupTestsPlan)
+	data.TrustCertificates = types.BoolValue(trustCertificatesPlan)
+	data.TrustFingerprints = types.BoolValue(trustFingerprintsPlan)
+
+	reportSetupTests(response.Data.SetupTests, "Destination", failOnSetupTestFailurePlan, &resp.Diagnostics)
+
+	// without rollback the destination is saved to state even if the apply fails, so it's marked as tainted instead of being lost
+	if resp.Diagnostics.HasError() && core.GetBoolOrDefault(data.RollbackOnFailure, false) &&
+		rollbackCreatedDestination(ctx, r.GetClient(), data.Id.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *destinationV2) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	var data model.DestinationV2ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.GetClient().NewDestinationDetails().DestinationID(data.Id.ValueString()).DoCustom(ctx)
+	if err != nil {
+		if response.Code == "NotFound_Destination" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to Read Destination V2 Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, response.Code, response.Message),
+		)
+		return
+	}
+
+	configMask, diags := core.DynamicToMap(ctx, data.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.ReadFromResponse(ctx, response, r.destinationMetadata(response.Data.Service), configMask)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *destinationV2) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	var plan, state model.DestinationV2ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planConfig, planDiags := core.DynamicToMap(ctx, plan.Config)
+	resp.Diagnostics.Append(planDiags...)
+	stateConfig, stateDiags := core.DynamicToMap(ctx, state.Config)
+	resp.Diagnostics.Append(stateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	slot := r.destinationMetadata(plan.Service.ValueString())
+	configPatch := core.PrepareConfigPatchDynamic(planConfig, stateConfig, slot)
+
+	runSetupTestsPlan := core.GetBoolOrDefault(plan.RunSetupTests, false)
+	trustCertificatesPlan := core.GetBoolOrDefault(plan.TrustCertificates, false)
+	trustFingerprintsPlan := core.GetBoolOrDefault(plan.TrustFingerprints, false)
+
+	svc := r.GetClient().NewDestinationUpdate().
+		DestinationID(state.Id.ValueString()).
+		RunSetupTests(runSetupTestsPlan).
+		TrustCertificates(trustCertificatesPlan).
+		TrustFingerprints(trustFingerprintsPlan)
+
+	if len(configPatch) > 0 {
+		svc.ConfigCustom(&configPatch)
+	}
+
+	r.applyUpdateRootFields(svc, plan, state)
+
+	response, err := svc.DoCustom(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Destination V2 Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, response.Code, response.Message),
+		)
+		return
+	}
+
+	reportSetupTests(response.Data.SetupTests, "Destination", core.GetBoolOrDefault(plan.FailOnSetupTestFailure, r.GetFailOnSetupTestFailure()), &resp.Diagnostics)
+
+	details, err := r.GetClient().NewDestinationDetails().DestinationID(state.Id.ValueString()).DoCustom(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Destination V2 Resource After Update.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, details.Code, details.Message),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.ReadFromResponse(ctx, details, slot, planConfig)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.RunSetupTests = types.BoolValue(runSetupTestsPlan)
+	plan.TrustCertificates = types.BoolValue(trustCertificatesPlan)
+	plan.TrustFingerprints = types.BoolValue(trustFingerprintsPlan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *destinationV2) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	var data model.DestinationV2ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteResponse, err := r.GetClient().NewDestinationDelete().DestinationID(data.Id.ValueString()).Do(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Destination V2 Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, deleteResponse.Code, deleteResponse.Message),
+		)
+		return
+	}
+}
+
+// destinationMetadata returns the config slot for the destination service. The API has no
+// destination metadata endpoint, so the slot is derived from the bundled destination fields.
+// Services unknown to this provider version get an empty slot: config is passed through as is.
+func (r *destinationV2) destinationMetadata(service string) *metadata.Property {
+	return core.StaticMetadataSlot(fivetranCommon.GetDestinationFieldsForService(service), service)
+}
+
+func (r *destinationV2) applyCreateRootFields(svc *destinations.DestinationCreateService, data model.DestinationV2ResourceModel) {
+	if !data.Region.IsNull() && !data.Region.IsUnknown() {
+		svc.Region(data.Region.ValueString())
+	}
+	if !data.DaylightSavingTimeEnabled.IsNull() && !data.DaylightSavingTimeEnabled.IsUnknown() {
+		svc.DaylightSavingTimeEnabled(data.DaylightSavingTimeEnabled.ValueBool())
+	}
+	if !data.HybridDeploymentAgentId.IsNull() && !data.HybridDeploymentAgentId.IsUnknown() {
+		svc.HybridDeploymentAgentId(data.HybridDeploymentAgentId.ValueString())
+	}
+	if !data.NetworkingMethod.IsNull() && !data.NetworkingMethod.IsUnknown() {
+		svc.NetworkingMethod(data.NetworkingMethod.ValueString())
+	}
+	if !data.PrivateLinkId.IsNull() && !data.PrivateLinkId.IsUnknown() {
+		svc.PrivateLinkId(data.PrivateLinkId.ValueString())
+	}
+	if !data.ProxyAgentId.IsNull() && !data.ProxyAgentId.IsUnknown() {
+		svc.ProxyAgentId(data.ProxyAgentId.ValueString())
+	}
+}
+
+func (r *destinationV2) applyUpdateRootFields(svc *destinations.DestinationUpdateService, plan, state model.DestinationV2ResourceModel) {
+	if !plan.Region.Equal(state.Region) && !plan.Region.IsNull() && !plan.Region.IsUnknown() {
+		svc.Region(plan.Region.ValueString())
+	}
+	if !plan.TimeZoneOffset.Equal(state.TimeZoneOffset) && !plan.TimeZoneOffset.IsNull() && !plan.TimeZoneOffset.IsUnknown() {
+		svc.TimeZoneOffset(plan.TimeZoneOffset.ValueString())
+	}
+	if !plan.DaylightSavingTimeEnabled.Equal(state.DaylightSavingTimeEnabled) && !plan.DaylightSavingTimeEnabled.IsNull() && !plan.DaylightSavingTimeEnabled.IsUnknown() {
+		svc.DaylightSavingTimeEnabled(plan.DaylightSavingTimeEnabled.ValueBool())
+	}
+	if !plan.HybridDeploymentAgentId.Equal(state.HybridDeploymentAgentId) && !plan.HybridDeploymentAgentId.IsNull() && !plan.HybridDeploymentAgentId.IsUnknown() {
+		svc.HybridDeploymentAgentId(plan.HybridDeploymentAgentId.ValueString())
+	}
+	if !plan.NetworkingMethod.Equal(state.NetworkingMethod) && !plan.NetworkingMethod.IsNull() && !plan.NetworkingMethod.IsUnknown() {
+		svc.NetworkingMethod(plan.NetworkingMethod.ValueString())
+	}
+	if !plan.PrivateLinkId.Equal(state.PrivateLinkId) && !plan.PrivateLinkId.IsNull() && !plan.PrivateLinkId.IsUnknown() {
+		svc.PrivateLinkId(plan.PrivateLinkId.ValueString())
+	}
+	if !plan.ProxyAgentId.Equal(state.ProxyAgentId) && !plan.ProxyAgentId.IsNull() && !plan.ProxyAgentId.IsUnknown() {
+		svc.ProxyAgentId(plan.ProxyAgentId.ValueString())
+	}
+}
@@ -0,0 +1,58 @@
+package resources_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/resources"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestDestinationV2SchemaShape(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	r := resources.DestinationV2()
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("schema diagnostics: %v", schemaResp.Diagnostics)
+	}
+
+	attrs := schemaResp.Schema.Attributes
+	if schemaResp.Schema.Version != 0 {
+		t.Fatalf("unexpected schema version: got %d, want 0", schemaResp.Schema.Version)
+	}
+	if len(schemaResp.Schema.Blocks) != 0 {
+		t.Fatal("fivetran_destination_v2 must not define blocks, config is a dynamic attribute")
+	}
+
+	assertStringAttribute(t, attrs, "service", true, false, false)
+	assertStringAttribute(t, attrs, "group_id", true, false, false)
+	assertStringAttribute(t, attrs, "time_zone_offset", true, false, false)
+	assertStringAttribute(t, attrs, "region", false, true, true)
+	assertStringAttribute(t, attrs, "setup_status", false, false, true)
+	assertDynamicAttribute(t, attrs, "config", true, true, false)
+	assertBoolAttribute(t, attrs, "daylight_saving_time_enabled", false, true, true)
+	assertBoolAttribute(t, attrs, "run_setup_tests", false, true, false)
+	assertBoolAttribute(t, attrs, "trust_certificates", false, true, false)
+	assertBoolAttribute(t, attrs, "trust_fingerprints", false, true, false)
+	assertBoolAttribute(t, attrs, "fail_on_setup_test_failure", false, true, false)
+	assertBoolAttribute(t, attrs, "rollback_on_failure", false, true, false)
+}
+
+func TestDestinationV2NotRegistered(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	p := framework.FivetranProvider()
+	for _, resourceFactory := range p.Resources(ctx) {
+		r := resourceFactory()
+		var metadataResp resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "fivetran"}, &metadataResp)
+		if metadataResp.TypeName == "fivetran_destination_v2" {
+			t.Fatal("fivetran_destination_v2 must remain unregistered until the registration ticket")
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/common"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDestinationV2ValidateConfig(t *testing.T) {
+	common.LoadDestinationFieldsMap()
+
+	tests := []struct {
+		name         string
+		service      string
+		config       map[string]interface{}
+		skip         bool
+		wantErrors   int
+		wantWarnings int
+	}{
+		{
+			name:    "known fields",
+			service: "snowflake",
+			config:  map[string]interface{}{"host": "account.snowflakecomputing.com", "port": int64(443), "password": "secret"},
+		},
+		{
+			name:       "wrong type",
+			service:    "snowflake",
+			config:     map[string]interface{}{"port": "not_a_port"},
+			wantErrors: 1,
+		},
+		{
+			name:         "field unknown to bundled destination fields",
+			service:      "snowflake",
+			config:       map[string]interface{}{"brand_new_option": true},
+			wantWarnings: 1,
+		},
+		{
+			name:    "service unknown to bundled destination fields",
+			service: "brand_new_warehouse",
+			config:  map[string]interface{}{"port": "not_a_port"},
+		},
+		{
+			name:    "skip plan time validation",
+			service: "snowflake",
+			config:  map[string]interface{}{"port": "not_a_port"},
+			skip:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			r := &destinationV2{}
+			configureProviderResource(t, &r.ProviderResource, nil, nil, tt.skip)
+
+			configValue, diags := core.MapToDynamic(ctx, tt.config)
+			if diags.HasError() {
+				t.Fatalf("config dynamic diagnostics: %v", diags)
+			}
+
+			var resp resource.ValidateConfigResponse
+			r.ValidateConfig(ctx, destinationV2ValidateConfigRequest(t, tt.service, configValue), &resp)
+
+			assertErrorCount(t, resp.Diagnostics, tt.wantErrors)
+			assertWarningCount(t, resp.Diagnostics, tt.wantWarnings)
+		})
+	}
+}
+
+func destinationV2ValidateConfigRequest(t *testing.T, service string, config types.Dynamic) resource.ValidateConfigRequest {
+	t.Helper()
+	ctx := context.Background()
+
+	data := model.DestinationV2ResourceModel{
+		Id:                        types.StringNull(),
+		GroupId:                   types.StringValue("group_id"),
+		Service:                   types.StringValue(service),
+		Region:                    types.StringNull(),
+		TimeZoneOffset:            types.StringValue("0"),
+		SetupStatus:               types.StringNull(),
+		DaylightSavingTimeEnabled: types.BoolNull(),
+		Config:                    config,
+		HybridDeploymentAgentId:   types.StringNull(),
+		NetworkingMethod:          types.StringNull(),
+		PrivateLinkId:             types.StringNull(),
+		ProxyAgentId:              types.StringNull(),
+		RunSetupTests:             types.BoolNull(),
+		TrustCertificates:         types.BoolNull(),
+		TrustFingerprints:         types.BoolNull(),
+		FailOnSetupTestFailure:    types.BoolNull(),
+		RollbackOnFailure:         types.BoolNull(),
+	}
+
+	var object types.Object
+	diags := tfsdk.ValueFrom(ctx, data, types.ObjectType{AttrTypes: model.DestinationV2ResourceModelAttrTypes()}, &object)
+	if diags.HasError() {
+		t.Fatalf("ValueFrom diagnostics: %v", diags)
+	}
+
+	raw, err := object.ToTerraformValue(ctx)
+	if err != nil {
+		t.Fatalf("converting config to Terraform value: %v", err)
+	}
+
+	return resource.ValidateConfigRequest{
+		Config: tfsdk.Config{
+			Raw:    raw,
+			Schema: fivetranSchema.DestinationV2ResourceSchema(),
+		},
+	}
+}
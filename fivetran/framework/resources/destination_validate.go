@@ -0,0 +1,44 @@
+package resources
+
+import (
+	"context"
+
+	fivetranCommon "github.com/fivetran/terraform-provider-fivetran/fivetran/common"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.ResourceWithValidateConfig = &destination{}
+
+func (r *destination) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if r.GetSkipPlanTimeValidation() {
+		return
+	}
+
+	var data model.DestinationResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Service.IsNull() || data.Service.IsUnknown() || data.Service.ValueString() == "" {
+		return
+	}
+
+	// Service specific field errors are reported on apply.
+	configMap, err := data.GetConfigMap(true)
+	if err != nil || len(configMap) == 0 {
+		return
+	}
+
+	slot := core.StaticMetadataSlot(fivetranCommon.GetDestinationFieldsForService(data.Service.ValueString()), data.Service.ValueString())
+	if len(slot.Properties) == 0 {
+		// The service is newer than the bundled destination fields, nothing to validate against.
+		return
+	}
+
+	// Bundled fields can lag behind the API, so unknown fields are reported as warnings.
+	validateLegacyDynamicObject(configMap, slot, path.Root("config"), r.GetFieldStatusPolicy(), &resp.Diagnostics)
+}
@@ -0,0 +1,80 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/common"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDestinationValidateConfig(t *testing.T) {
+	common.LoadDestinationFieldsMap()
+
+	tests := []struct {
+		name         string
+		service      string
+		config       map[string]tftypes.Value
+		skip         bool
+		wantWarnings int
+	}{
+		{
+			name:    "known fields",
+			service: "snowflake",
+			config: map[string]tftypes.Value{
+				"host": tftypes.NewValue(tftypes.String, "account.snowflakecomputing.com"),
+				"port": tftypes.NewValue(tftypes.Number, 443),
+			},
+		},
+		{
+			name:    "field of another service",
+			service: "snowflake",
+			config: map[string]tftypes.Value{
+				"host":       tftypes.NewValue(tftypes.String, "account.snowflakecomputing.com"),
+				"project_id": tftypes.NewValue(tftypes.String, "project"),
+			},
+			wantWarnings: 1,
+		},
+		{
+			name:    "service unknown to bundled destination fields",
+			service: "brand_new_warehouse",
+			config: map[string]tftypes.Value{
+				"project_id": tftypes.NewValue(tftypes.String, "project"),
+			},
+		},
+		{
+			name:    "skip plan time validation",
+			service: "snowflake",
+			config: map[string]tftypes.Value{
+				"project_id": tftypes.NewValue(tftypes.String, "project"),
+			},
+			skip: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			r := &destination{}
+			configureProviderResource(t, &r.ProviderResource, nil, nil, tt.skip)
+
+			var schemaResp resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+			config := configWithValues(t, schemaResp.Schema, map[string]tftypes.Value{
+				"group_id":         tftypes.NewValue(tftypes.String, "group_id"),
+				"service":          tftypes.NewValue(tftypes.String, tt.service),
+				"time_zone_offset": tftypes.NewValue(tftypes.String, "0"),
+			}, map[string]map[string]tftypes.Value{"config": tt.config})
+
+			var resp resource.ValidateConfigResponse
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, &resp)
+
+			assertErrorCount(t, resp.Diagnostics, 0)
+			assertWarningCount(t, resp.Diagnostics, tt.wantWarnings)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.ResourceWithValidateConfig = &externalLoggingV2{}
+
+func (r *externalLoggingV2) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if r.GetSkipPlanTimeValidation() {
+		return
+	}
+
+	var data model.ExternalLoggingV2ResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Service.IsNull() || data.Service.IsUnknown() || data.Service.ValueString() == "" {
+		return
+	}
+
+	configMap, diags := core.DynamicToMapPreserveUnknown(ctx, data.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || len(configMap) == 0 {
+		return
+	}
+
+	slot := r.externalLoggingMetadata(data.Service.ValueString())
+	if len(slot.Properties) == 0 {
+		// The log service is newer than the bundled external logging fields, nothing to validate against.
+		return
+	}
+
+	// Bundled fields can lag behind the API, so unknown fields are reported as warnings.
+	validateLegacyDynamicObject(configMap, slot, path.Root("config"), r.GetFieldStatusPolicy(), &resp.Diagnostics)
+}
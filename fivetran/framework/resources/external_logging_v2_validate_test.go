@@ -0,0 +1,114 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/common"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestExternalLoggingV2ValidateConfig(t *testing.T) {
+	common.LoadExternalLoggingFieldsMap()
+
+	tests := []struct {
+		name         string
+		service      string
+		config       map[string]interface{}
+		skip         bool
+		wantErrors   int
+		wantWarnings int
+	}{
+		{
+			name:    "known fields",
+			service: "splunkLog",
+			config:  map[string]interface{}{"host": "splunk.example.com", "port": int64(8088), "token": "secret", "enable_ssl": true},
+		},
+		{
+			name:    "string value coerced to integer",
+			service: "splunkLog",
+			config:  map[string]interface{}{"port": "8088"},
+		},
+		{
+			name:       "wrong type",
+			service:    "splunkLog",
+			config:     map[string]interface{}{"port": "not_a_port"},
+			wantErrors: 1,
+		},
+		{
+			name:         "field of another log service",
+			service:      "datadog_log",
+			config:       map[string]interface{}{"api_key": "secret", "log_group_name": "fivetran"},
+			wantWarnings: 1,
+		},
+		{
+			name:    "service unknown to bundled external logging fields",
+			service: "brand_new_log_service",
+			config:  map[string]interface{}{"port": "not_a_port"},
+		},
+		{
+			name:    "skip plan time validation",
+			service: "splunkLog",
+			config:  map[string]interface{}{"port": "not_a_port"},
+			skip:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			r := &externalLoggingV2{}
+			configureProviderResource(t, &r.ProviderResource, nil, nil, tt.skip)
+
+			configValue, diags := core.MapToDynamic(ctx, tt.config)
+			if diags.HasError() {
+				t.Fatalf("config dynamic diagnostics: %v", diags)
+			}
+
+			var resp resource.ValidateConfigResponse
+			r.ValidateConfig(ctx, externalLoggingV2ValidateConfigRequest(t, tt.service, configValue), &resp)
+
+			assertErrorCount(t, resp.Diagnostics, tt.wantErrors)
+			assertWarningCount(t, resp.Diagnostics, tt.wantWarnings)
+		})
+	}
+}
+
+func externalLoggingV2ValidateConfigRequest(t *testing.T, service string, config types.Dynamic) resource.ValidateConfigRequest {
+	t.Helper()
+	ctx := context.Background()
+
+	data := model.ExternalLoggingV2ResourceModel{
+		Id:            types.StringNull(),
+		GroupId:       types.StringValue("group_id"),
+		Service:       types.StringValue(service),
+		Enabled:       types.BoolNull(),
+		Config:        config,
+		RunSetupTests: types.BoolNull(),
+	}
+
+	var object types.Object
+	diags := tfsdk.ValueFrom(ctx, data, types.ObjectType{AttrTypes: model.ExternalLoggingV2ResourceModelAttrTypes()}, &object)
+	if diags.HasError() {
+		t.Fatalf("ValueFrom diagnostics: %v", diags)
+	}
+
+	raw, err := object.ToTerraformValue(ctx)
+	if err != nil {
+		t.Fatalf("converting config to Terraform value: %v", err)
+	}
+
+	return resource.ValidateConfigRequest{
+		Config: tfsdk.Config{
+			Raw:    raw,
+			Schema: fivetranSchema.ExternalLoggingV2ResourceSchema(),
+		},
+	}
+}
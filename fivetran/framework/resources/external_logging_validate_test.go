@@ -0,0 +1,80 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/common"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestExternalLoggingValidateConfig(t *testing.T) {
+	common.LoadExternalLoggingFieldsMap()
+
+	tests := []struct {
+		name         string
+		service      string
+		config       map[string]tftypes.Value
+		skip         bool
+		wantWarnings int
+	}{
+		{
+			name:    "known fields",
+			service: "splunkLog",
+			config: map[string]tftypes.Value{
+				"host":  tftypes.NewValue(tftypes.String, "splunk.example.com"),
+				"port":  tftypes.NewValue(tftypes.Number, 8088),
+				"token": tftypes.NewValue(tftypes.String, "token"),
+			},
+		},
+		{
+			name:    "field of another log service",
+			service: "splunkLog",
+			config: map[string]tftypes.Value{
+				"token":    tftypes.NewValue(tftypes.String, "token"),
+				"hostname": tftypes.NewValue(tftypes.String, "datadog.example.com"),
+			},
+			wantWarnings: 1,
+		},
+		{
+			name:    "log service unknown to bundled external logging fields",
+			service: "brand_new_log",
+			config: map[string]tftypes.Value{
+				"hostname": tftypes.NewValue(tftypes.String, "example.com"),
+			},
+		},
+		{
+			name:    "skip plan time validation",
+			service: "splunkLog",
+			config: map[string]tftypes.Value{
+				"hostname": tftypes.NewValue(tftypes.String, "datadog.example.com"),
+			},
+			skip: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			r := &externalLogging{}
+			configureProviderResource(t, &r.ProviderResource, nil, nil, tt.skip)
+
+			var schemaResp resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+			config := configWithValues(t, schemaResp.Schema, map[string]tftypes.Value{
+				"group_id": tftypes.NewValue(tftypes.String, "group_id"),
+				"service":  tftypes.NewValue(tftypes.String, tt.service),
+			}, map[string]map[string]tftypes.Value{"config": tt.config})
+
+			var resp resource.ValidateConfigResponse
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, &resp)
+
+			assertErrorCount(t, resp.Diagnostics, 0)
+			assertWarningCount(t, resp.Diagnostics, tt.wantWarnings)
+		})
+	}
+}
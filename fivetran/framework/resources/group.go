@@ -7,8 +7,8 @@ import (
     "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
     "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
     fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
-    "github.com/hashicorp/terraform-plugin-framework/path"
     "github.com/hashicorp/terraform-plugin-framework/resource"
+    "github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func Group() resource.Resource {
@@ -22,6 +22,7 @@ type group struct {
 // Ensure the implementation satisfies the desired interfaces.
 var _ resource.ResourceWithConfigure = &group{}
 var _ resource.ResourceWithImportState = &group{}
+var _ resource.ResourceWithIdentity = &group{}
 
 func (r *group) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
     resp.TypeName = req.ProviderTypeName + "_group"
@@ -31,8 +32,13 @@ func (r *group) Schema(ctx context.Context, req resource.SchemaRequest, resp *re
     resp.Schema = fivetranSchema.GroupResource()
 }
 
+func (r *group) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+    resp.IdentitySchema = fivetranSchema.IdIdentitySchema("The unique identifier for the group within the Fivetran system.")
+}
+
 func (r *group) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-    resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+    id, err := resolveImportId(ctx, r.GetClient(), req, resolveGroupImportId)
+    importResolvedId(ctx, resp, "Unable to Import Group Resource.", id, err, "id")
 }
 
 
@@ -46,7 +52,7 @@ func (r *group) Create(ctx context.Context, req resource.CreateRequest, resp *re
         return
     }
 
-    var data model.Group
+    var data model.GroupResourceModel
 
     // Read Terraform plan data into the model
     resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -55,6 +61,36 @@ func (r *group) Create(ctx context.Context, req resource.CreateRequest, resp *re
         return
     }
 
+    adoptExistingPlan := core.GetBoolOrDefault(data.AdoptExisting, false)
+
+    if adoptExistingPlan {
+        existingId, err := findGroupIdByName(ctx, r.GetClient(), data.Name.ValueString())
+        if err != nil {
+            resp.Diagnostics.AddError(
+                "Unable to Create Group Resource.",
+                fmt.Sprintf("Error while looking up the group to adopt. %v", err),
+            )
+            return
+        }
+        if existingId != "" {
+            groupReadResponse, err := r.GetClient().NewGroupDetails().GroupID(existingId).Do(ctx)
+            if err != nil {
+                resp.Diagnostics.AddError(
+                    "Unable to Adopt Group Resource.",
+                    fmt.Sprintf("%v; code: %v; message: %v", err, groupReadResponse.Code, groupReadResponse.Message),
+                )
+                return
+            }
+
+            data.ReadFromResponse(ctx, groupReadResponse)
+            data.AdoptExisting = types.BoolValue(adoptExistingPlan)
+
+            resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+            resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentity(data.Id))...)
+            return
+        }
+    }
+
     svc := r.GetClient().NewGroupCreate()
     svc.Name(data.Name.ValueString())
 
@@ -69,8 +105,10 @@ func (r *group) Create(ctx context.Context, req resource.CreateRequest, resp *re
     }
 
     data.ReadFromResponse(ctx, groupCreateResponse)
+    data.AdoptExisting = types.BoolValue(adoptExistingPlan)
 
     resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+    resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentity(data.Id))...)
 }
 
 func (r *group) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -83,7 +121,7 @@ func (r *group) Read(ctx context.Context, req resource.ReadRequest, resp *resour
         return
     }
 
-    var data model.Group
+    var data model.GroupResourceModel
 
     // Read Terraform prior state data into the model
     resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -105,6 +143,7 @@ func (r *group) Read(ctx context.Context, req resource.ReadRequest, resp *resour
     data.ReadFromResponse(ctx, groupReadResponse)
 
     resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+    resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentity(data.Id))...)
 }
 
 func (r *group) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -117,7 +156,7 @@ func (r *group) Update(ctx context.Context, req resource.UpdateRequest, resp *re
         return
     }
 
-    var plan, state model.Group
+    var plan, state model.GroupResourceModel
     hasChanges := false
 
     resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -142,8 +181,10 @@ func (r *group) Update(ctx context.Context, req resource.UpdateRequest, resp *re
 
         state.ReadFromResponse(ctx, groupUpdateResponse)
     }
+    state.AdoptExisting = types.BoolValue(core.GetBoolOrDefault(plan.AdoptExisting, false))
 
     resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+    resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentity(state.Id))...)
 }
 
 func (r *group) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -156,7 +197,7 @@ func (r *group) Delete(ctx context.Context, req resource.DeleteRequest, resp *re
         return
     }
 
-    var data model.Group
+    var data model.GroupResourceModel
 
     resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
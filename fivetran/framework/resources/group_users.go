@@ -9,7 +9,6 @@ import (
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
 	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
@@ -40,7 +39,8 @@ func (r *groupUser) Schema(ctx context.Context, req resource.SchemaRequest, resp
 }
 
 func (r *groupUser) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id, err := resolveGroupImportId(ctx, r.GetClient(), req.ID)
+	importResolvedId(ctx, resp, "Unable to Import Group Users Resource.", id, err, "id", "group_id")
 }
 
 func (r *groupUser) getTerraformUserId(ctx context.Context) string {
@@ -22,6 +22,7 @@ type hybridDeploymentAgent struct {
 // Ensure the implementation satisfies the desired interfaces.
 var _ resource.ResourceWithConfigure = &hybridDeploymentAgent{}
 var _ resource.ResourceWithImportState = &hybridDeploymentAgent{}
+var _ resource.ResourceWithIdentity = &hybridDeploymentAgent{}
 
 func (r *hybridDeploymentAgent) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
     resp.TypeName = req.ProviderTypeName + "_hybrid_deployment_agent"
@@ -31,8 +32,12 @@ func (r *hybridDeploymentAgent) Schema(ctx context.Context, req resource.SchemaR
     resp.Schema = fivetranSchema.HybridDeploymentAgentResource()
 }
 
+func (r *hybridDeploymentAgent) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+    resp.IdentitySchema = fivetranSchema.IdIdentitySchema("The unique identifier for the hybrid deployment agent within your account.")
+}
+
 func (r *hybridDeploymentAgent) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-    resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+    resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *hybridDeploymentAgent) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -74,6 +79,7 @@ func (r *hybridDeploymentAgent) Create(ctx context.Context, req resource.CreateR
 	data.ReadFromCreateResponse(createResponse)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentity(data.Id))...)
 }
 
 func (r *hybridDeploymentAgent) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -108,6 +114,7 @@ func (r *hybridDeploymentAgent) Read(ctx context.Context, req resource.ReadReque
     data.ReadFromResponse(readResponse)
 
     resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+    resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentity(data.Id))...)
 }
 
 func (r *hybridDeploymentAgent) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -141,6 +148,7 @@ func (r *hybridDeploymentAgent) Update(ctx context.Context, req resource.UpdateR
     state.ReadFromCreateResponse(updateResponse)
 
     resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+    resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentity(state.Id))...)
 }
 
 func (r *hybridDeploymentAgent) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
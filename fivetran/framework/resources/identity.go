@@ -0,0 +1,33 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// setIdentity sets the resource identity, the identity is nil when the resource methods are called outside of the framework.
+func setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, value any) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+	return identity.Set(ctx, value)
+}
+
+// idIdentity is the identity of resources identified by their id alone.
+func idIdentity(id types.String) model.IdIdentity {
+	return model.IdIdentity{Id: id}
+}
+
+// connectionIdentity is the identity of connections, the connection `name` is the destination schema name.
+func connectionIdentity(id, groupId, schemaName types.String) model.ConnectionIdentity {
+	return model.ConnectionIdentity{Id: id, GroupId: groupId, SchemaName: schemaName}
+}
+
+// teamMembershipIdentity is the identity of team memberships, all memberships of the team are managed by one resource.
+func teamMembershipIdentity(teamId types.String) model.TeamMembershipIdentity {
+	return model.TeamMembershipIdentity{TeamId: teamId}
+}
@@ -0,0 +1,97 @@
+package resources
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// importByIdentity imports the resource by the given identity values, identity attributes without values are null.
+func importByIdentity(t *testing.T, r resource.ResourceWithIdentity, values map[string]string) resource.ImportStateResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	var identitySchemaResp resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchemaResp)
+
+	identityType := identitySchemaResp.IdentitySchema.Type().TerraformType(ctx).(tftypes.Object)
+	attributes := make(map[string]tftypes.Value, len(identityType.AttributeTypes))
+	for name := range identityType.AttributeTypes {
+		if value, ok := values[name]; ok {
+			attributes[name] = tftypes.NewValue(tftypes.String, value)
+		} else {
+			attributes[name] = tftypes.NewValue(tftypes.String, nil)
+		}
+	}
+
+	resp := resource.ImportStateResponse{
+		State:    nullState(ctx, schemaResp.Schema),
+		Identity: &tfsdk.ResourceIdentity{Schema: identitySchemaResp.IdentitySchema, Raw: tftypes.NewValue(identityType, nil)},
+	}
+	r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{
+		Identity: &tfsdk.ResourceIdentity{Schema: identitySchemaResp.IdentitySchema, Raw: tftypes.NewValue(identityType, attributes)},
+	}, &resp)
+	return resp
+}
+
+func assertImportedId(t *testing.T, resp resource.ImportStateResponse, expected string) {
+	t.Helper()
+
+	var stateId, identityId types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("id"), &stateId)...)
+	resp.Diagnostics.Append(resp.Identity.GetAttribute(context.Background(), path.Root("id"), &identityId)...)
+	assertNoDiagnostics(t, resp.Diagnostics)
+	if stateId.ValueString() != expected || identityId.ValueString() != expected {
+		t.Errorf("imported id = %v, identity id = %v, want %v", stateId, identityId, expected)
+	}
+}
+
+func TestGroupImportByIdentity(t *testing.T) {
+	t.Parallel()
+
+	r := &group{}
+	configureProviderResource(t, &r.ProviderResource, importTestClient(map[string]string{}), &sync.Map{}, false)
+
+	assertImportedId(t, importByIdentity(t, r, map[string]string{"id": "group_id"}), "group_id")
+}
+
+func TestConnectorImportByIdentity(t *testing.T) {
+	t.Parallel()
+
+	r := &connector{}
+	configureProviderResource(t, &r.ProviderResource, importTestClient(map[string]string{
+		"GET /v1/groups/group_id/connections": `{"code":"Success","data":{"items":[
+			{"id":"connection_id","schema":"salesforce"},{"id":"other_connection_id","schema":"hubspot"}]}}`,
+	}), &sync.Map{}, false)
+
+	assertImportedId(t, importByIdentity(t, r, map[string]string{"id": "connection_id"}), "connection_id")
+	assertImportedId(t, importByIdentity(t, r, map[string]string{"group_id": "group_id", "schema_name": "salesforce"}), "connection_id")
+
+	assertErrorCount(t, importByIdentity(t, r, map[string]string{"group_id": "group_id", "schema_name": "stripe"}).Diagnostics, 1)
+	assertErrorCount(t, importByIdentity(t, r, map[string]string{"group_id": "group_id"}).Diagnostics, 1)
+}
+
+func TestTeamMembershipImportByIdentity(t *testing.T) {
+	t.Parallel()
+
+	for _, r := range []resource.ResourceWithIdentity{&teamGroupMembership{}, &teamConnectorMembership{}, &teamUserMembership{}} {
+		resp := importByIdentity(t, r, map[string]string{"team_id": "team_id"})
+
+		var stateId, stateTeamId, identityTeamId types.String
+		resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("id"), &stateId)...)
+		resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("team_id"), &stateTeamId)...)
+		resp.Diagnostics.Append(resp.Identity.GetAttribute(context.Background(), path.Root("team_id"), &identityTeamId)...)
+		assertNoDiagnostics(t, resp.Diagnostics)
+		if stateId.ValueString() != "team_id" || stateTeamId.ValueString() != "team_id" || identityTeamId.ValueString() != "team_id" {
+			t.Errorf("%T imported id = %v, team_id = %v, identity team_id = %v, want team_id", r, stateId, stateTeamId, identityTeamId)
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// importIdResolver resolves a readable import ID to the resource id.
+type importIdResolver func(ctx context.Context, client *fivetran.Client, importId string) (string, error)
+
+// importResolvedId imports the resource by the id the import ID resolves to, the id is set to all given attributes
+// and to the `id` of the resource identity for resources with identity.
+func importResolvedId(ctx context.Context, resp *resource.ImportStateResponse, summary string, id string, err error, attributes ...string) {
+	if err != nil {
+		resp.Diagnostics.AddError(summary, err.Error())
+		return
+	}
+	for _, attribute := range attributes {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attribute), id)...)
+	}
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("id"), id)...)
+	}
+}
+
+// resolveImportId resolves the import ID of the request, resources imported by identity are imported by the identity `id` as is.
+func resolveImportId(ctx context.Context, client *fivetran.Client, req resource.ImportStateRequest, resolve importIdResolver) (string, error) {
+	if req.ID != "" || req.Identity == nil {
+		return resolve(ctx, client, req.ID)
+	}
+
+	var identity model.IdIdentity
+	if diags := req.Identity.Get(ctx, &identity); diags.HasError() {
+		return "", fmt.Errorf("Unable to read the resource identity. %v", diags.Errors()[0].Detail())
+	}
+	return identity.Id.ValueString(), nil
+}
+
+// importResolvedTeamId imports team memberships by the team id the import ID resolves to, the team id is set to the `id`
+// and `team_id` attributes and to the `team_id` of the resource identity.
+func importResolvedTeamId(ctx context.Context, resp *resource.ImportStateResponse, summary string, teamId string, err error) {
+	if err != nil {
+		resp.Diagnostics.AddError(summary, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), teamId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), teamId)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, teamMembershipIdentity(types.StringValue(teamId)))...)
+}
+
+// resolveTeamMembershipImport resolves the import ID of team membership requests, team memberships imported by identity
+// are imported by the identity `team_id` as is.
+func resolveTeamMembershipImport(ctx context.Context, client *fivetran.Client, req resource.ImportStateRequest, resolve importIdResolver) (string, error) {
+	if req.ID != "" || req.Identity == nil {
+		return resolve(ctx, client, req.ID)
+	}
+
+	var identity model.TeamMembershipIdentity
+	if diags := req.Identity.Get(ctx, &identity); diags.HasError() {
+		return "", fmt.Errorf("Unable to read the resource identity. %v", diags.Errors()[0].Detail())
+	}
+	return identity.TeamId.ValueString(), nil
+}
+
+// resolveConnectionImport resolves the import ID of the connection request, connections imported by identity are imported
+// either by the identity `id` or by the identity `group_id` and `schema_name`.
+func resolveConnectionImport(ctx context.Context, client *fivetran.Client, req resource.ImportStateRequest) (string, error) {
+	if req.ID != "" || req.Identity == nil {
+		return resolveConnectionImportId(ctx, client, req.ID)
+	}
+
+	var identity model.ConnectionIdentity
+	if diags := req.Identity.Get(ctx, &identity); diags.HasError() {
+		return "", fmt.Errorf("Unable to read the resource identity. %v", diags.Errors()[0].Detail())
+	}
+	if identity.Id.ValueString() != "" {
+		return identity.Id.ValueString(), nil
+	}
+	if identity.GroupId.ValueString() == "" || identity.SchemaName.ValueString() == "" {
+		return "", fmt.Errorf("Either `id` or both `group_id` and `schema_name` should be set in the connection identity.")
+	}
+
+	connectionId, err := findConnectionIdBySchema(ctx, client, identity.GroupId.ValueString(), identity.SchemaName.ValueString())
+	if err != nil {
+		return "", err
+	}
+	if connectionId == "" {
+		return "", fmt.Errorf("Connection with '%v' schema name doesn't exist in group '%v'.", identity.SchemaName.ValueString(), identity.GroupId.ValueString())
+	}
+	return connectionId, nil
+}
+
+// resolveConnectionImportId resolves `group_name/schema_name` import IDs to the connection id, connection ids are returned as is.
+func resolveConnectionImportId(ctx context.Context, client *fivetran.Client, importId string) (string, error) {
+	groupName, schemaName, found := strings.Cut(importId, "/")
+	if !found {
+		return importId, nil
+	}
+
+	groupId, err := findGroupIdByName(ctx, client, groupName)
+	if err != nil {
+		return "", err
+	}
+	if groupId == "" {
+		return "", fmt.Errorf("Group with '%v' name doesn't exist.", groupName)
+	}
+
+	connectionId, err := findConnectionIdBySchema(ctx, client, groupId, schemaName)
+	if err != nil {
+		return "", err
+	}
+	if connectionId == "" {
+		return "", fmt.Errorf("Connection with '%v' schema name doesn't exist in group '%v'.", schemaName, groupName)
+	}
+	return connectionId, nil
+}
+
+// resolveGroupImportId resolves group names to the group id, group ids are returned as is. The import ID is looked up
+// as a group id first, the groups are listed only when no group has that id.
+// Destinations share the id with their group, so destinations are imported by group name as well.
+func resolveGroupImportId(ctx context.Context, client *fivetran.Client, importId string) (string, error) {
+	response, _ := client.NewGroupDetails().GroupID(importId).Do(ctx)
+	if !strings.HasPrefix(response.Code, "NotFound") {
+		return importId, nil
+	}
+
+	groupId, err := findGroupIdByName(ctx, client, importId)
+	if err != nil {
+		return "", err
+	}
+	if groupId == "" {
+		return "", fmt.Errorf("Group with '%v' id or name doesn't exist.", importId)
+	}
+	return groupId, nil
+}
+
+// resolveUserImportId resolves emails to the user id, user ids are returned as is.
+func resolveUserImportId(ctx context.Context, client *fivetran.Client, importId string) (string, error) {
+	if !strings.Contains(importId, "@") {
+		return importId, nil
+	}
+
+	userId, err := findUserIdByEmail(ctx, client, importId)
+	if err != nil {
+		return "", err
+	}
+	if userId == "" {
+		return "", fmt.Errorf("User with '%v' email doesn't exist.", importId)
+	}
+	return userId, nil
+}
+
+// resolveTeamImportId resolves team names to the team id, team ids are returned as is. The import ID is looked up
+// as a team id first, the teams are listed only when no team has that id.
+func resolveTeamImportId(ctx context.Context, client *fivetran.Client, importId string) (string, error) {
+	response, _ := client.NewTeamsDetails().TeamId(importId).Do(ctx)
+	if !strings.HasPrefix(response.Code, "NotFound") {
+		return importId, nil
+	}
+
+	teamId, err := findTeamIdByName(ctx, client, importId)
+	if err != nil {
+		return "", err
+	}
+	if teamId == "" {
+		return "", fmt.Errorf("Team with '%v' id or name doesn't exist.", importId)
+	}
+	return teamId, nil
+}
+
+// resolveTeamGroupMembershipImportId resolves `team_name:group_name` import IDs to the team id, once the team is checked to have
+// a membership in the group. The resource manages all group memberships of the team, so the team name or id is enough as well.
+func resolveTeamGroupMembershipImportId(ctx context.Context, client *fivetran.Client, importId string) (string, error) {
+	teamName, groupName, found := strings.Cut(importId, ":")
+	teamId, err := resolveTeamImportId(ctx, client, teamName)
+	if err != nil || !found {
+		return teamId, err
+	}
+
+	groupId, err := resolveGroupImportId(ctx, client, groupName)
+	if err != nil {
+		return "", err
+	}
+	if response, err := client.NewTeamGroupMembershipDetails().TeamId(teamId).GroupId(groupId).Do(ctx); err != nil {
+		return "", fmt.Errorf("Team '%v' has no membership in group '%v'. %v; code: %v", teamName, groupName, err, response.Code)
+	}
+	return teamId, nil
+}
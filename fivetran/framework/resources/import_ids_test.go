@@ -0,0 +1,148 @@
+package resources
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func importTestClient(routes map[string]string) *fivetran.Client {
+	client := fivetran.New("key", "secret")
+	client.SetHttpClient(&requestRecordingHTTPClient{routes: routes})
+	return client
+}
+
+const importTestGroups = `{"code":"Success","data":{"items":[{"id":"group_id","name":"analytics"},{"id":"other_id","name":"marketing"},{"id":"copy_id","name":"marketing"}]}}`
+
+func TestResolveConnectionImportId(t *testing.T) {
+	t.Parallel()
+
+	client := importTestClient(map[string]string{
+		"GET /v1/groups": importTestGroups,
+		"GET /v1/groups/group_id/connections": `{"code":"Success","data":{"items":[
+			{"id":"connection_id","schema":"salesforce"},{"id":"other_connection_id","schema":"hubspot"}]}}`,
+	})
+
+	for importId, expected := range map[string]string{
+		"analytics/salesforce": "connection_id",
+		"connection_id":        "connection_id",
+	} {
+		if id, err := resolveConnectionImportId(context.Background(), client, importId); err != nil || id != expected {
+			t.Errorf("resolveConnectionImportId(%v) = %v, %v, want %v", importId, id, err, expected)
+		}
+	}
+
+	for importId, expected := range map[string]string{
+		"analytics/stripe":     "Connection with 'stripe' schema name doesn't exist in group 'analytics'.",
+		"finance/salesforce":   "Group with 'finance' name doesn't exist.",
+		"marketing/salesforce": "Ambiguous groups found with 'marketing' name.",
+	} {
+		if _, err := resolveConnectionImportId(context.Background(), client, importId); err == nil || !strings.HasPrefix(err.Error(), expected) {
+			t.Errorf("resolveConnectionImportId(%v) error = %v, want %v", importId, err, expected)
+		}
+	}
+}
+
+func TestResolveGroupImportId(t *testing.T) {
+	t.Parallel()
+
+	client := importTestClient(map[string]string{
+		"GET /v1/groups":          importTestGroups,
+		"GET /v1/groups/group_id": `{"code":"Success","data":{"id":"group_id","name":"analytics"}}`,
+	})
+
+	for importId, expected := range map[string]string{
+		"analytics": "group_id",
+		"group_id":  "group_id",
+	} {
+		if id, err := resolveGroupImportId(context.Background(), client, importId); err != nil || id != expected {
+			t.Errorf("resolveGroupImportId(%v) = %v, %v, want %v", importId, id, err, expected)
+		}
+	}
+	if _, err := resolveGroupImportId(context.Background(), client, "marketing"); err == nil || !strings.Contains(err.Error(), "other_id, copy_id") {
+		t.Errorf("ambiguous group name should list the matching ids, error: %v", err)
+	}
+	if _, err := resolveGroupImportId(context.Background(), client, "finance"); err == nil || err.Error() != "Group with 'finance' id or name doesn't exist." {
+		t.Errorf("unknown group name error: %v", err)
+	}
+}
+
+func TestResolveImportIdDoesNotListExistingIds(t *testing.T) {
+	t.Parallel()
+
+	// the list endpoints fail, existing ids are imported without them
+	httpClient := &requestRecordingHTTPClient{routes: map[string]string{
+		"GET /v1/groups":          `{"code":"AccessDenied","message":"Forbidden"}`,
+		"GET /v1/teams":           `{"code":"AccessDenied","message":"Forbidden"}`,
+		"GET /v1/groups/group_id": `{"code":"Success","data":{"id":"group_id","name":"analytics"}}`,
+		"GET /v1/teams/team_id":   `{"code":"Success","data":{"id":"team_id","name":"Data Engineers"}}`,
+	}}
+	client := fivetran.New("key", "secret")
+	client.SetHttpClient(httpClient)
+
+	if id, err := resolveGroupImportId(context.Background(), client, "group_id"); err != nil || id != "group_id" {
+		t.Errorf("resolveGroupImportId(group_id) = %v, %v", id, err)
+	}
+	if id, err := resolveTeamImportId(context.Background(), client, "team_id"); err != nil || id != "team_id" {
+		t.Errorf("resolveTeamImportId(team_id) = %v, %v", id, err)
+	}
+	for _, request := range httpClient.requests {
+		if request == "GET /v1/groups" || request == "GET /v1/teams" {
+			t.Errorf("existing ids shouldn't be resolved through %v", request)
+		}
+	}
+}
+
+func TestResolveTeamImportId(t *testing.T) {
+	t.Parallel()
+
+	client := importTestClient(map[string]string{
+		"GET /v1/teams":         `{"code":"Success","data":{"items":[{"id":"team_id","name":"Data Engineers"}]}}`,
+		"GET /v1/teams/team_id": `{"code":"Success","data":{"id":"team_id","name":"Data Engineers"}}`,
+	})
+
+	for importId, expected := range map[string]string{
+		"Data Engineers": "team_id",
+		"team_id":        "team_id",
+	} {
+		if id, err := resolveTeamImportId(context.Background(), client, importId); err != nil || id != expected {
+			t.Errorf("resolveTeamImportId(%v) = %v, %v, want %v", importId, id, err, expected)
+		}
+	}
+	if _, err := resolveTeamImportId(context.Background(), client, "Analysts"); err == nil {
+		t.Errorf("unknown team name should fail the import")
+	}
+}
+
+func TestUserGroupMembershipImportByEmail(t *testing.T) {
+	t.Parallel()
+
+	client := importTestClient(map[string]string{
+		"GET /v1/users": `{"code":"Success","data":{"items":[{"id":"user_id","email":"john@example.com"}]}}`,
+	})
+
+	r := &userGroupMembership{}
+	configureProviderResource(t, &r.ProviderResource, client, &sync.Map{}, false)
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+	resp := resource.ImportStateResponse{State: nullState(context.Background(), schemaResp.Schema)}
+	r.ImportState(context.Background(), resource.ImportStateRequest{ID: "john@example.com"}, &resp)
+	assertNoDiagnostics(t, resp.Diagnostics)
+
+	var userId types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("user_id"), &userId)...)
+	if userId.ValueString() != "user_id" {
+		t.Errorf("user_id = %v, want user_id", userId)
+	}
+
+	resp = resource.ImportStateResponse{State: nullState(context.Background(), schemaResp.Schema)}
+	r.ImportState(context.Background(), resource.ImportStateRequest{ID: "jane@example.com"}, &resp)
+	assertErrorCount(t, resp.Diagnostics, 1)
+}
@@ -0,0 +1,368 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// List resources are implemented by the managed resources, so the listed resources are read by the resource Read
+// the same way as after `terraform import` when `terraform query` asks for the resource representation.
+
+func ConnectorList() list.ListResource {
+	return &connector{}
+}
+
+func ConnectionList() list.ListResource {
+	return &connection{}
+}
+
+func DestinationList() list.ListResource {
+	return &destination{}
+}
+
+func GroupList() list.ListResource {
+	return &group{}
+}
+
+func UserList() list.ListResource {
+	return &user{}
+}
+
+func TeamList() list.ListResource {
+	return &team{}
+}
+
+func WebhookList() list.ListResource {
+	return &webhook{}
+}
+
+func TransformationList() list.ListResource {
+	return &transformation{}
+}
+
+var _ list.ListResourceWithConfigure = &connector{}
+var _ list.ListResourceWithConfigure = &connection{}
+var _ list.ListResourceWithConfigure = &destination{}
+var _ list.ListResourceWithConfigure = &group{}
+var _ list.ListResourceWithConfigure = &user{}
+var _ list.ListResourceWithConfigure = &team{}
+var _ list.ListResourceWithConfigure = &webhook{}
+var _ list.ListResourceWithConfigure = &transformation{}
+
+// listedResource is a resource found by the list endpoint.
+type listedResource struct {
+	id          string
+	displayName string
+	identity    any
+}
+
+type connectionListFilter struct {
+	GroupId    types.String `tfsdk:"group_id"`
+	Service    types.String `tfsdk:"service"`
+	SchemaName types.String `tfsdk:"schema_name"`
+}
+
+func connectionListSchema() listschema.Schema {
+	return listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"group_id": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Lists the connections of the group (destination) with the given id only.",
+			},
+			"service": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Lists the connections of the given service only.",
+			},
+			"schema_name": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Lists the connections with the given destination schema name only.",
+			},
+		},
+	}
+}
+
+func (r *connector) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = connectionListSchema()
+}
+
+func (r *connector) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	if r.GetClient() == nil {
+		stream.Results = unconfiguredClientResults()
+		return
+	}
+	stream.Results = listConnections(ctx, r, r.GetClient(), req)
+}
+
+func (r *connection) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = connectionListSchema()
+}
+
+func (r *connection) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	if r.GetClient() == nil {
+		stream.Results = unconfiguredClientResults()
+		return
+	}
+	stream.Results = listConnections(ctx, r, r.GetClient(), req)
+}
+
+// listConnections lists the connections for both fivetran_connector and fivetran_connection, the service filter is applied
+// to the listed connections as the list endpoint filters by group and schema only.
+func listConnections(ctx context.Context, r resource.Resource, client *fivetran.Client, req list.ListRequest) iter.Seq[list.ListResult] {
+	var filter connectionListFilter
+	if diags := req.Config.Get(ctx, &filter); diags.HasError() {
+		return list.ListResultsStreamDiagnostics(diags)
+	}
+
+	listResponse, err := core.ListConnections(ctx, client, filter.GroupId.ValueString(), filter.SchemaName.ValueString())
+	if err != nil {
+		return listErrorResults("Unable to List Connection Resources.", fmt.Sprintf("%v; code: %v", err, listResponse.Code))
+	}
+
+	var listed []listedResource
+	for _, c := range listResponse.Data.Items {
+		if filter.Service.ValueString() != "" && c.Service != filter.Service.ValueString() {
+			continue
+		}
+		listed = append(listed, listedResource{
+			id:          c.ID,
+			displayName: fmt.Sprintf("%v (%v)", c.Schema, c.Service),
+			identity:    connectionIdentity(types.StringValue(c.ID), types.StringValue(c.GroupID), types.StringValue(c.Schema)),
+		})
+	}
+	return listResults(ctx, r, req, listed)
+}
+
+func (r *destination) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{}
+}
+
+func (r *destination) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	if r.GetClient() == nil {
+		stream.Results = unconfiguredClientResults()
+		return
+	}
+
+	listResponse, err := core.ListDestinations(ctx, r.GetClient())
+	if err != nil {
+		stream.Results = listErrorResults("Unable to List Destination Resources.", fmt.Sprintf("%v; code: %v", err, listResponse.Code))
+		return
+	}
+
+	var listed []listedResource
+	for _, d := range listResponse.Data.Items {
+		listed = append(listed, listedResource{
+			id:          d.ID,
+			displayName: fmt.Sprintf("%v (%v)", d.Service, d.GroupID),
+			identity:    idIdentity(types.StringValue(d.ID)),
+		})
+	}
+	stream.Results = listResults(ctx, r, req, listed)
+}
+
+func (r *group) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{}
+}
+
+func (r *group) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	if r.GetClient() == nil {
+		stream.Results = unconfiguredClientResults()
+		return
+	}
+
+	listResponse, err := core.ListGroups(ctx, r.GetClient())
+	if err != nil {
+		stream.Results = listErrorResults("Unable to List Group Resources.", fmt.Sprintf("%v; code: %v", err, listResponse.Code))
+		return
+	}
+
+	var listed []listedResource
+	for _, g := range listResponse.Data.Items {
+		listed = append(listed, listedResource{
+			id:          g.ID,
+			displayName: g.Name,
+			identity:    idIdentity(types.StringValue(g.ID)),
+		})
+	}
+	stream.Results = listResults(ctx, r, req, listed)
+}
+
+func (r *user) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{}
+}
+
+func (r *user) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	if r.GetClient() == nil {
+		stream.Results = unconfiguredClientResults()
+		return
+	}
+
+	listResponse, err := core.ListUsers(ctx, r.GetClient())
+	if err != nil {
+		stream.Results = listErrorResults("Unable to List User Resources.", fmt.Sprintf("%v; code: %v", err, listResponse.Code))
+		return
+	}
+
+	var listed []listedResource
+	for _, u := range listResponse.Data.Items {
+		listed = append(listed, listedResource{
+			id:          u.ID,
+			displayName: u.Email,
+			identity:    idIdentity(types.StringValue(u.ID)),
+		})
+	}
+	stream.Results = listResults(ctx, r, req, listed)
+}
+
+func (r *team) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{}
+}
+
+func (r *team) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	if r.GetClient() == nil {
+		stream.Results = unconfiguredClientResults()
+		return
+	}
+
+	listResponse, err := core.ListTeams(ctx, r.GetClient())
+	if err != nil {
+		stream.Results = listErrorResults("Unable to List Team Resources.", fmt.Sprintf("%v; code: %v", err, listResponse.Code))
+		return
+	}
+
+	var listed []listedResource
+	for _, t := range listResponse.Data.Items {
+		listed = append(listed, listedResource{
+			id:          t.Id,
+			displayName: t.Name,
+			identity:    idIdentity(types.StringValue(t.Id)),
+		})
+	}
+	stream.Results = listResults(ctx, r, req, listed)
+}
+
+func (r *webhook) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{}
+}
+
+func (r *webhook) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	if r.GetClient() == nil {
+		stream.Results = unconfiguredClientResults()
+		return
+	}
+
+	listResponse, err := core.ListWebhooks(ctx, r.GetClient())
+	if err != nil {
+		stream.Results = listErrorResults("Unable to List Webhook Resources.", fmt.Sprintf("%v; code: %v", err, listResponse.Code))
+		return
+	}
+
+	var listed []listedResource
+	for _, w := range listResponse.Data.Items {
+		listed = append(listed, listedResource{
+			id:          w.Id,
+			displayName: w.Url,
+			identity:    idIdentity(types.StringValue(w.Id)),
+		})
+	}
+	stream.Results = listResults(ctx, r, req, listed)
+}
+
+func (r *transformation) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{}
+}
+
+func (r *transformation) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	if r.GetClient() == nil {
+		stream.Results = unconfiguredClientResults()
+		return
+	}
+
+	listResponse, err := core.ListTransformations(ctx, r.GetClient())
+	if err != nil {
+		stream.Results = listErrorResults("Unable to List Transformation Resources.", fmt.Sprintf("%v; code: %v", err, listResponse.Code))
+		return
+	}
+
+	var listed []listedResource
+	for _, t := range listResponse.Data.Items {
+		// dbt core transformations are named, quickstart transformations are named by their package
+		displayName := t.TransformationConfig.Name
+		if displayName == "" {
+			displayName = t.TransformationConfig.PackageName
+		}
+		listed = append(listed, listedResource{
+			id:          t.Id,
+			displayName: displayName,
+			identity:    idIdentity(types.StringValue(t.Id)),
+		})
+	}
+	stream.Results = listResults(ctx, r, req, listed)
+}
+
+func unconfiguredClientResults() iter.Seq[list.ListResult] {
+	return listErrorResults("Unconfigured Fivetran Client", "Please report this issue to the provider developers.")
+}
+
+func listErrorResults(summary, detail string) iter.Seq[list.ListResult] {
+	var diags diag.Diagnostics
+	diags.AddError(summary, detail)
+	return list.ListResultsStreamDiagnostics(diags)
+}
+
+// listResults streams at most `req.Limit` listed resources. When the resource representation is requested, each resource
+// is read by the resource Read from the state with the resource id only, resources deleted in the meantime are skipped.
+func listResults(ctx context.Context, r resource.Resource, req list.ListRequest, listed []listedResource) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		pushed := int64(0)
+		for _, l := range listed {
+			if req.Limit > 0 && pushed >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = l.displayName
+			result.Diagnostics.Append(result.Identity.Set(ctx, l.identity)...)
+			if req.IncludeResource && !result.Diagnostics.HasError() && !readListedResource(ctx, r, req, l.id, &result) {
+				continue
+			}
+
+			pushed++
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// readListedResource reads the listed resource into the result, returns false if the resource doesn't exist anymore.
+func readListedResource(ctx context.Context, r resource.Resource, req list.ListRequest, id string, result *list.ListResult) bool {
+	state := tfsdk.State{Schema: req.ResourceSchema, Raw: result.Resource.Raw}
+	if result.Diagnostics.Append(state.SetAttribute(ctx, path.Root("id"), id)...); result.Diagnostics.HasError() {
+		return true
+	}
+
+	resp := resource.ReadResponse{
+		State:    state,
+		Identity: &tfsdk.ResourceIdentity{Schema: result.Identity.Schema, Raw: result.Identity.Raw.Copy()},
+	}
+	r.Read(ctx, resource.ReadRequest{State: state, Identity: result.Identity}, &resp)
+	result.Diagnostics.Append(resp.Diagnostics...)
+	if !resp.Diagnostics.HasError() && resp.State.Raw.IsNull() {
+		return false
+	}
+
+	result.Resource = &tfsdk.Resource{Schema: req.ResourceSchema, Raw: resp.State.Raw}
+	result.Identity = resp.Identity
+	return true
+}
@@ -0,0 +1,111 @@
+package resources
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type listTestResource interface {
+	resource.ResourceWithIdentity
+	list.ListResource
+}
+
+// collectListResults lists the resources with the given list config values and collects the streamed results.
+func collectListResults(t *testing.T, r listTestResource, values map[string]string, includeResource bool, limit int64) []list.ListResult {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	var identitySchemaResp resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchemaResp)
+	var listSchemaResp list.ListResourceSchemaResponse
+	r.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &listSchemaResp)
+
+	configType := listSchemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attributes := make(map[string]tftypes.Value, len(configType.AttributeTypes))
+	for name := range configType.AttributeTypes {
+		if value, ok := values[name]; ok {
+			attributes[name] = tftypes.NewValue(tftypes.String, value)
+		} else {
+			attributes[name] = tftypes.NewValue(tftypes.String, nil)
+		}
+	}
+
+	var stream list.ListResultsStream
+	r.List(ctx, list.ListRequest{
+		Config:                 tfsdk.Config{Schema: listSchemaResp.Schema, Raw: tftypes.NewValue(configType, attributes)},
+		IncludeResource:        includeResource,
+		Limit:                  limit,
+		ResourceSchema:         schemaResp.Schema,
+		ResourceIdentitySchema: identitySchemaResp.IdentitySchema,
+	}, &stream)
+
+	var results []list.ListResult
+	for result := range stream.Results {
+		assertNoDiagnostics(t, result.Diagnostics)
+		results = append(results, result)
+	}
+	return results
+}
+
+func TestConnectorListFiltersByService(t *testing.T) {
+	t.Parallel()
+
+	r := &connector{}
+	configureProviderResource(t, &r.ProviderResource, importTestClient(map[string]string{
+		"GET /v1/connections": `{"code":"Success","data":{"items":[
+			{"id":"connection_id","group_id":"group_id","service":"salesforce","schema":"salesforce"},
+			{"id":"other_connection_id","group_id":"group_id","service":"hubspot","schema":"hubspot"}]}}`,
+	}), &sync.Map{}, false)
+
+	results := collectListResults(t, r, map[string]string{"service": "salesforce"}, false, 0)
+	if len(results) != 1 {
+		t.Fatalf("listed %v connections, want 1", len(results))
+	}
+
+	var identity model.ConnectionIdentity
+	results[0].Diagnostics.Append(results[0].Identity.Get(context.Background(), &identity)...)
+	assertNoDiagnostics(t, results[0].Diagnostics)
+	if identity.Id.ValueString() != "connection_id" || identity.GroupId.ValueString() != "group_id" || identity.SchemaName.ValueString() != "salesforce" {
+		t.Errorf("identity = %v, want connection_id in group_id with salesforce schema", identity)
+	}
+	if results[0].DisplayName != "salesforce (salesforce)" {
+		t.Errorf("display name = %v", results[0].DisplayName)
+	}
+}
+
+func TestGroupListReadsResources(t *testing.T) {
+	t.Parallel()
+
+	r := &group{}
+	configureProviderResource(t, &r.ProviderResource, importTestClient(map[string]string{
+		"GET /v1/groups":          importTestGroups,
+		"GET /v1/groups/group_id": `{"code":"Success","data":{"id":"group_id","name":"analytics","created_at":"2026-01-01T00:00:00Z"}}`,
+	}), &sync.Map{}, false)
+
+	if results := collectListResults(t, r, nil, false, 0); len(results) != 3 {
+		t.Errorf("listed %v groups, want 3", len(results))
+	}
+
+	results := collectListResults(t, r, nil, true, 1)
+	if len(results) != 1 {
+		t.Fatalf("listed %v groups with limit 1, want 1", len(results))
+	}
+
+	var name types.String
+	results[0].Diagnostics.Append(results[0].Resource.GetAttribute(context.Background(), path.Root("name"), &name)...)
+	assertNoDiagnostics(t, results[0].Diagnostics)
+	if name.ValueString() != "analytics" || results[0].DisplayName != "analytics" {
+		t.Errorf("listed group name = %v, display name = %v, want analytics", name, results[0].DisplayName)
+	}
+}
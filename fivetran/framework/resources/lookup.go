@@ -0,0 +1,92 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/connections"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+)
+
+// findConnectionIdBySchema returns the id of the connection with the given schema name in the group, or "" if there is none.
+// Returns an error if there are several.
+func findConnectionIdBySchema(ctx context.Context, client *fivetran.Client, groupId, schemaName string) (string, error) {
+	items, err := core.ListAll(func(cursor string) ([]connections.DetailsResponseDataCommon, string, error) {
+		response, err := core.WithCursor(client.NewGroupListConnections().GroupID(groupId).Limit(core.ListPageLimit), cursor).Do(ctx)
+		if err != nil {
+			return nil, "", fmt.Errorf("%v; code: %v; message: %v", err, response.Code, response.Message)
+		}
+		return response.Data.Items, response.Data.NextCursor, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	var ids []string
+	for _, c := range items {
+		if c.Schema == schemaName {
+			ids = append(ids, c.ID)
+		}
+	}
+	return singleId(ids, fmt.Sprintf("Ambiguous connections found with '%v' group_id and '%v' schema name.", groupId, schemaName))
+}
+
+// findGroupIdByName returns the id of the group with the given name, or "" if there is none.
+// Returns an error if there are several.
+func findGroupIdByName(ctx context.Context, client *fivetran.Client, name string) (string, error) {
+	response, err := core.ListGroups(ctx, client)
+	if err != nil {
+		return "", fmt.Errorf("%v; code: %v; message: %v", err, response.Code, response.Message)
+	}
+	var ids []string
+	for _, g := range response.Data.Items {
+		if g.Name == name {
+			ids = append(ids, g.ID)
+		}
+	}
+	return singleId(ids, fmt.Sprintf("Ambiguous groups found with '%v' name.", name))
+}
+
+// findUserIdByEmail returns the id of the user with the given email, or "" if there is none.
+// Returns an error if there are several.
+func findUserIdByEmail(ctx context.Context, client *fivetran.Client, email string) (string, error) {
+	response, err := core.ListUsers(ctx, client)
+	if err != nil {
+		return "", fmt.Errorf("%v; code: %v; message: %v", err, response.Code, response.Message)
+	}
+	var ids []string
+	for _, u := range response.Data.Items {
+		if u.Email == email {
+			ids = append(ids, u.ID)
+		}
+	}
+	return singleId(ids, fmt.Sprintf("Ambiguous users found with '%v' email.", email))
+}
+
+// findTeamIdByName returns the id of the team with the given name, or "" if there is none.
+// Returns an error if there are several.
+func findTeamIdByName(ctx context.Context, client *fivetran.Client, name string) (string, error) {
+	response, err := core.ListTeams(ctx, client)
+	if err != nil {
+		return "", fmt.Errorf("%v; code: %v", err, response.Code)
+	}
+	var ids []string
+	for _, t := range response.Data.Items {
+		if t.Name == name {
+			ids = append(ids, t.Id)
+		}
+	}
+	return singleId(ids, fmt.Sprintf("Ambiguous teams found with '%v' name.", name))
+}
+
+func singleId(ids []string, ambiguous string) (string, error) {
+	switch len(ids) {
+	case 0:
+		return "", nil
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("%v Matching ids: %v", ambiguous, strings.Join(ids, ", "))
+	}
+}
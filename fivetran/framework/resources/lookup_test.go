@@ -0,0 +1,214 @@
+package resources
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func createWithRecordedRequests(t *testing.T, r resource.ResourceWithConfigure, routes map[string]string,
+	values map[string]tftypes.Value, objects map[string]map[string]tftypes.Value) (*requestRecordingHTTPClient, resource.CreateResponse) {
+	t.Helper()
+
+	httpClient := &requestRecordingHTTPClient{routes: routes}
+	client := fivetran.New("key", "secret")
+	client.SetHttpClient(httpClient)
+
+	var configureResp resource.ConfigureResponse
+	r.Configure(context.Background(), resource.ConfigureRequest{
+		ProviderData: &core.ProviderResourceData{Client: client, MetadataCache: &sync.Map{}},
+	}, &configureResp)
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+	config := configWithValues(t, schemaResp.Schema, values, objects)
+	resp := resource.CreateResponse{State: nullState(context.Background(), schemaResp.Schema)}
+	r.Create(context.Background(), resource.CreateRequest{Config: config, Plan: tfsdk.Plan{Raw: config.Raw, Schema: schemaResp.Schema}}, &resp)
+	return httpClient, resp
+}
+
+func TestGroupCreateAdoptsExistingGroup(t *testing.T) {
+	t.Parallel()
+
+	httpClient, resp := createWithRecordedRequests(t, &group{}, map[string]string{
+		"GET /v1/groups":          `{"code":"Success","data":{"items":[{"id":"other_id","name":"other"},{"id":"group_id","name":"analytics"}]}}`,
+		"GET /v1/groups/group_id": `{"code":"Success","data":{"id":"group_id","name":"analytics","created_at":"2026-01-01T00:00:00Z"}}`,
+	}, map[string]tftypes.Value{
+		"name":           tftypes.NewValue(tftypes.String, "analytics"),
+		"adopt_existing": tftypes.NewValue(tftypes.Bool, true),
+	}, nil)
+	assertNoDiagnostics(t, resp.Diagnostics)
+
+	var id types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("id"), &id)...)
+	if id.ValueString() != "group_id" || slices.Contains(httpClient.requests, "POST /v1/groups") {
+		t.Errorf("existing group should be adopted, id: %v, requests: %v", id, httpClient.requests)
+	}
+}
+
+func TestGroupCreateWithoutExistingGroup(t *testing.T) {
+	t.Parallel()
+
+	httpClient, resp := createWithRecordedRequests(t, &group{}, map[string]string{
+		"GET /v1/groups":  `{"code":"Success","data":{"items":[{"id":"other_id","name":"other"}]}}`,
+		"POST /v1/groups": `{"code":"Success","data":{"id":"group_id","name":"analytics","created_at":"2026-01-01T00:00:00Z"}}`,
+	}, map[string]tftypes.Value{
+		"name":           tftypes.NewValue(tftypes.String, "analytics"),
+		"adopt_existing": tftypes.NewValue(tftypes.Bool, true),
+	}, nil)
+	assertNoDiagnostics(t, resp.Diagnostics)
+
+	if !slices.Contains(httpClient.requests, "POST /v1/groups") {
+		t.Errorf("group should be created, requests: %v", httpClient.requests)
+	}
+}
+
+func TestUserCreateAdoptsExistingUser(t *testing.T) {
+	t.Parallel()
+
+	const existingUser = `{"code":"Success","data":{"id":"user_id","email":"john@example.com","given_name":"John","family_name":"White",
+		"verified":true,"invited":false,"role":"Account Reviewer","created_at":"2026-01-01T00:00:00Z","logged_in_at":"2026-01-01T00:00:00Z"}}`
+	httpClient, resp := createWithRecordedRequests(t, &user{}, map[string]string{
+		"GET /v1/users":           `{"code":"Success","data":{"items":[{"id":"user_id","email":"john@example.com"}]}}`,
+		"GET /v1/users/user_id":   existingUser,
+		"PATCH /v1/users/user_id": existingUser,
+	}, map[string]tftypes.Value{
+		"email":          tftypes.NewValue(tftypes.String, "john@example.com"),
+		"given_name":     tftypes.NewValue(tftypes.String, "John"),
+		"family_name":    tftypes.NewValue(tftypes.String, "White"),
+		"role":           tftypes.NewValue(tftypes.String, "Account Reviewer"),
+		"adopt_existing": tftypes.NewValue(tftypes.Bool, true),
+	}, nil)
+	assertNoDiagnostics(t, resp.Diagnostics)
+
+	if slices.Contains(httpClient.requests, "POST /v1/users") || !slices.Contains(httpClient.requests, "PATCH /v1/users/user_id") {
+		t.Errorf("existing user should be adopted and updated, requests: %v", httpClient.requests)
+	}
+}
+
+const (
+	adoptTestConnections = `{"code":"Success","data":{"items":[{"id":"other_id","schema":"other"},{"id":"connection_id","schema":"postgres_schema"}]}}`
+	adoptTestConnection  = `{"code":"Success","data":{"id":"connection_id","group_id":"group_id","service":"postgres","schema":"postgres_schema",
+		"paused":true,"data_delay_sensitivity":"LOW","status":{"setup_state":"connected"}}}`
+	adoptTestUpdatedConnection = `{"code":"Success","data":{"id":"connection_id","group_id":"group_id","service":"postgres","schema":"postgres_schema",
+		"paused":true,"data_delay_sensitivity":"HIGH","status":{"setup_state":"connected"}}}`
+)
+
+func adoptConnectionConfig(service string) (map[string]tftypes.Value, map[string]map[string]tftypes.Value) {
+	return map[string]tftypes.Value{
+		"group_id":               tftypes.NewValue(tftypes.String, "group_id"),
+		"service":                tftypes.NewValue(tftypes.String, service),
+		"data_delay_sensitivity": tftypes.NewValue(tftypes.String, "HIGH"),
+		"adopt_existing":         tftypes.NewValue(tftypes.Bool, true),
+	}, map[string]map[string]tftypes.Value{
+		"destination_schema": {"prefix": tftypes.NewValue(tftypes.String, "postgres_schema")},
+	}
+}
+
+func assertStringState(t *testing.T, state tfsdk.State, attribute, expected string) {
+	t.Helper()
+	var value types.String
+	diags := state.GetAttribute(context.Background(), path.Root(attribute), &value)
+	assertNoDiagnostics(t, diags)
+	if value.ValueString() != expected {
+		t.Errorf("%v = %v, want %v", attribute, value, expected)
+	}
+}
+
+func TestConnectorCreateAdoptsExistingConnector(t *testing.T) {
+	t.Parallel()
+
+	for _, r := range []resource.ResourceWithConfigure{&connector{}, &connection{}} {
+		values, objects := adoptConnectionConfig("postgres")
+		httpClient, resp := createWithRecordedRequests(t, r, map[string]string{
+			"GET /v1/groups/group_id/connections": adoptTestConnections,
+			"GET /v1/connections/connection_id":   adoptTestConnection,
+			"PATCH /v1/connections/connection_id": adoptTestUpdatedConnection,
+		}, values, objects)
+		assertNoDiagnostics(t, resp.Diagnostics)
+
+		if slices.Contains(httpClient.requests, "POST /v1/connections") || !slices.Contains(httpClient.requests, "PATCH /v1/connections/connection_id") {
+			t.Errorf("%T: existing connection should be adopted and updated, requests: %v", r, httpClient.requests)
+		}
+		// the adopted connection is updated from its own state, so only the changed field is patched
+		if patch := httpClient.bodies["PATCH /v1/connections/connection_id"]; !strings.Contains(patch, `"data_delay_sensitivity":"HIGH"`) || strings.Contains(patch, `"config"`) {
+			t.Errorf("%T: unexpected patch: %v", r, patch)
+		}
+		assertStringState(t, resp.State, "id", "connection_id")
+	}
+}
+
+func TestConnectorCreateDoesNotAdoptOtherService(t *testing.T) {
+	t.Parallel()
+
+	for _, r := range []resource.ResourceWithConfigure{&connector{}, &connection{}} {
+		values, objects := adoptConnectionConfig("mysql")
+		httpClient, resp := createWithRecordedRequests(t, r, map[string]string{
+			"GET /v1/groups/group_id/connections": adoptTestConnections,
+			"GET /v1/connections/connection_id":   adoptTestConnection,
+			"PATCH /v1/connections/connection_id": adoptTestUpdatedConnection,
+		}, values, objects)
+
+		assertErrorCount(t, resp.Diagnostics, 1)
+		if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, "has service `postgres`, not `mysql`") {
+			t.Errorf("%T: unexpected error: %v", r, detail)
+		}
+		if slices.Contains(httpClient.requests, "POST /v1/connections") || slices.Contains(httpClient.requests, "PATCH /v1/connections/connection_id") {
+			t.Errorf("%T: connection of another service shouldn't be changed, requests: %v", r, httpClient.requests)
+		}
+		if !resp.State.Raw.IsNull() {
+			t.Errorf("%T: state = %v, want none", r, resp.State.Raw)
+		}
+	}
+}
+
+func TestTeamCreateAdoptsExistingTeam(t *testing.T) {
+	t.Parallel()
+
+	httpClient, resp := createWithRecordedRequests(t, &team{}, map[string]string{
+		"GET /v1/teams":           `{"code":"Success","data":{"items":[{"id":"team_id","name":"Data Engineers"}]}}`,
+		"GET /v1/teams/team_id":   `{"code":"Success","data":{"id":"team_id","name":"Data Engineers","description":"old","role":"Account Reviewer"}}`,
+		"PATCH /v1/teams/team_id": `{"code":"Success","data":{"id":"team_id","name":"Data Engineers","description":"Data team","role":"Account Analyst"}}`,
+	}, map[string]tftypes.Value{
+		"name":           tftypes.NewValue(tftypes.String, "Data Engineers"),
+		"description":    tftypes.NewValue(tftypes.String, "Data team"),
+		"role":           tftypes.NewValue(tftypes.String, "Account Analyst"),
+		"adopt_existing": tftypes.NewValue(tftypes.Bool, true),
+	}, nil)
+	assertNoDiagnostics(t, resp.Diagnostics)
+
+	if slices.Contains(httpClient.requests, "POST /v1/teams") || !slices.Contains(httpClient.requests, "PATCH /v1/teams/team_id") {
+		t.Errorf("existing team should be adopted and updated, requests: %v", httpClient.requests)
+	}
+	assertStringState(t, resp.State, "id", "team_id")
+	assertStringState(t, resp.State, "role", "Account Analyst")
+	assertStringState(t, resp.State, "description", "Data team")
+}
+
+func TestConnectorSchemaName(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		destinationSchema map[string]interface{}
+		expected          string
+	}{
+		{map[string]interface{}{"schema_prefix": "prefix"}, "prefix"},
+		{map[string]interface{}{"schema": "schema"}, "schema"},
+		{map[string]interface{}{"schema": "schema", "table": "table"}, "schema.table"},
+		{map[string]interface{}{}, ""},
+	} {
+		if actual := connectorSchemaName(tc.destinationSchema); actual != tc.expected {
+			t.Errorf("connectorSchemaName(%v) = %v, want %v", tc.destinationSchema, actual, tc.expected)
+		}
+	}
+}
@@ -24,6 +24,7 @@ type proxy struct {
 // Ensure the implementation satisfies the desired interfaces.
 var _ resource.ResourceWithConfigure = &proxy{}
 var _ resource.ResourceWithImportState = &proxy{}
+var _ resource.ResourceWithIdentity = &proxy{}
 
 func (r *proxy) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
     resp.TypeName = req.ProviderTypeName + "_proxy_agent"
@@ -33,8 +34,12 @@ func (r *proxy) Schema(ctx context.Context, req resource.SchemaRequest, resp *re
     resp.Schema = fivetranSchema.ProxyAgentResource()
 }
 
+func (r *proxy) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+    resp.IdentitySchema = fivetranSchema.IdIdentitySchema("The unique identifier for the proxy within your account.")
+}
+
 func (r *proxy) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-    resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+    resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *proxy) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -90,6 +95,7 @@ func (r *proxy) Create(ctx context.Context, req resource.CreateRequest, resp *re
 	}
     
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentity(data.Id))...)
 }
 
 func (r *proxy) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -124,6 +130,7 @@ func (r *proxy) Read(ctx context.Context, req resource.ReadRequest, resp *resour
     data.ReadFromResponse(readResponse)
 
     resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+    resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentity(data.Id))...)
 }
 
 func (r *proxy) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -175,6 +182,7 @@ func (r *proxy) Update(ctx context.Context, req resource.UpdateRequest, resp *re
 	}
     
     resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+    resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentity(state.Id))...)
 }
 
 func (r *proxy) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -0,0 +1,60 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/common"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// reportSetupTests reports setup tests that are neither PASSED nor SKIPPED,
+// as errors naming the test if failOnFailure is set, as warnings otherwise.
+func reportSetupTests(setupTests []common.SetupTestResponse, subject string, failOnFailure bool, diags *diag.Diagnostics) {
+	for _, tr := range setupTests {
+		if tr.Status == "PASSED" || tr.Status == "SKIPPED" {
+			continue
+		}
+		summary := fmt.Sprintf("%v setup test `%v` has status `%v`", subject, tr.Title, tr.Status)
+		if failOnFailure {
+			diags.AddError(summary, tr.Message)
+		} else {
+			diags.AddWarning(summary, tr.Message)
+		}
+	}
+}
+
+// rollbackCreatedConnection deletes the connection created by a failed apply, so a retry starts clean.
+// Returns false if the connection couldn't be deleted, it has to be kept in state then.
+func rollbackCreatedConnection(ctx context.Context, client *fivetran.Client, connectionId string, diags *diag.Diagnostics) bool {
+	if response, err := client.NewConnectionDelete().ConnectionID(connectionId).Do(ctx); err != nil {
+		diags.AddError(
+			"Unable to Roll Back Connection Creation.",
+			fmt.Sprintf("Connection %v is kept in state as tainted. %v; code: %v; message: %v", connectionId, err, response.Code, response.Message),
+		)
+		return false
+	}
+	diags.AddWarning(
+		"Connection Creation Rolled Back.",
+		fmt.Sprintf("Connection %v was deleted, because `rollback_on_failure` is set.", connectionId),
+	)
+	return true
+}
+
+// rollbackCreatedDestination deletes the destination created by a failed apply, so a retry starts clean.
+// Returns false if the destination couldn't be deleted, it has to be kept in state then.
+func rollbackCreatedDestination(ctx context.Context, client *fivetran.Client, destinationId string, diags *diag.Diagnostics) bool {
+	if response, err := client.NewDestinationDelete().DestinationID(destinationId).Do(ctx); err != nil {
+		diags.AddError(
+			"Unable to Roll Back Destination Creation.",
+			fmt.Sprintf("Destination %v is kept in state as tainted. %v; code: %v; message: %v", destinationId, err, response.Code, response.Message),
+		)
+		return false
+	}
+	diags.AddWarning(
+		"Destination Creation Rolled Back.",
+		fmt.Sprintf("Destination %v was deleted, because `rollback_on_failure` is set.", destinationId),
+	)
+	return true
+}
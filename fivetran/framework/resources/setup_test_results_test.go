@@ -0,0 +1,148 @@
+package resources
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// requestRecordingHTTPClient records requests as "METHOD path" with their bodies and responds with the body routed for the request,
+// creating POST requests are answered with 201.
+type requestRecordingHTTPClient struct {
+	routes   map[string]string
+	mutex    sync.Mutex
+	requests []string
+	bodies   map[string]string
+}
+
+func (c *requestRecordingHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	request := req.Method + " " + req.URL.Path
+	var body []byte
+	if req.Body != nil {
+		body, _ = io.ReadAll(req.Body)
+	}
+	c.mutex.Lock()
+	c.requests = append(c.requests, request)
+	if c.bodies == nil {
+		c.bodies = map[string]string{}
+	}
+	c.bodies[request] = string(body)
+	c.mutex.Unlock()
+	if body, ok := c.routes[request]; ok {
+		resp, err := staticHTTPClient{body: body}.Do(req)
+		if req.Method == http.MethodPost && !strings.HasSuffix(req.URL.Path, "/test") {
+			resp.StatusCode = http.StatusCreated
+		}
+		return resp, err
+	}
+	return staticHTTPClient{body: `{"code":"NotFound","message":"unexpected request"}`}.Do(req)
+}
+
+func TestDestinationCreateFailsOnSetupTestFailure(t *testing.T) {
+	t.Parallel()
+
+	const failedSetup = `{"code":"Success","data":{"id":"destination_id","group_id":"destination_id","service":"snowflake","setup_status":"broken",
+		"setup_tests":[{"title":"Host Connection","status":"FAILED","message":"Connection refused"},{"title":"Validate Permissions","status":"PASSED"}]}}`
+
+	for _, tc := range []struct {
+		resource resource.ResourceWithConfigure
+		rollback bool
+	}{
+		{&destination{}, false},
+		{&destination{}, true},
+		{&destinationV2{}, false},
+		{&destinationV2{}, true},
+	} {
+		rollback := tc.rollback
+		httpClient := &requestRecordingHTTPClient{routes: map[string]string{
+			"POST /v1/destinations":                     failedSetup,
+			"POST /v1/destinations/destination_id/test": failedSetup,
+			"GET /v1/destinations/destination_id":       failedSetup,
+			"DELETE /v1/destinations/destination_id":    `{"code":"Success"}`,
+		}}
+		client := fivetran.New("key", "secret")
+		client.SetHttpClient(httpClient)
+
+		r := tc.resource
+		var configureResp resource.ConfigureResponse
+		r.Configure(context.Background(), resource.ConfigureRequest{
+			ProviderData: &core.ProviderResourceData{Client: client, MetadataCache: &sync.Map{}, FailOnSetupTestFailure: true},
+		}, &configureResp)
+		var schemaResp resource.SchemaResponse
+		r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+		config := configWithValues(t, schemaResp.Schema, map[string]tftypes.Value{
+			"group_id":            tftypes.NewValue(tftypes.String, "destination_id"),
+			"service":             tftypes.NewValue(tftypes.String, "snowflake"),
+			"time_zone_offset":    tftypes.NewValue(tftypes.String, "0"),
+			"rollback_on_failure": tftypes.NewValue(tftypes.Bool, rollback),
+		}, nil)
+		resp := resource.CreateResponse{State: nullState(context.Background(), schemaResp.Schema)}
+		r.Create(context.Background(), resource.CreateRequest{Config: config, Plan: tfsdk.Plan{Raw: config.Raw, Schema: schemaResp.Schema}}, &resp)
+
+		assertErrorCount(t, resp.Diagnostics, 1)
+		if summary := resp.Diagnostics.Errors()[0].Summary(); summary != "Destination setup test `Host Connection` has status `FAILED`" {
+			t.Errorf("%T rollback %v: unexpected error: %v", r, rollback, resp.Diagnostics)
+		}
+		deleted := httpClient.requests[len(httpClient.requests)-1] == "DELETE /v1/destinations/destination_id"
+		if deleted != rollback || resp.State.Raw.IsNull() != rollback {
+			t.Errorf("%T rollback %v: destination deleted = %v, state = %v", r, rollback, deleted, resp.State.Raw)
+		}
+	}
+}
+
+func TestConnectorCreateRollsBackFailedWaitFor(t *testing.T) {
+	t.Parallel()
+
+	const created = `{"code":"Success","data":{"id":"connection_id","group_id":"group_id","service":"postgres","schema":"postgres_schema",
+		"paused":true,"status":{"setup_state":"incomplete"},"setup_tests":[{"title":"Host Connection","status":"PASSED"}]}}`
+	const broken = `{"code":"Success","data":{"id":"connection_id","group_id":"group_id","service":"postgres","schema":"postgres_schema",
+		"paused":true,"status":{"setup_state":"broken","tasks":[{"code":"reconnect","message":"Reconnect the connection"}]}}}`
+
+	for _, rollback := range []bool{false, true} {
+		httpClient := &requestRecordingHTTPClient{routes: map[string]string{
+			"POST /v1/connections":                 created,
+			"GET /v1/connections/connection_id":    broken,
+			"DELETE /v1/connections/connection_id": `{"code":"Success"}`,
+		}}
+		client := fivetran.New("key", "secret")
+		client.SetHttpClient(httpClient)
+
+		r := &connector{}
+		var configureResp resource.ConfigureResponse
+		r.Configure(context.Background(), resource.ConfigureRequest{
+			ProviderData: &core.ProviderResourceData{Client: client, MetadataCache: &sync.Map{}},
+		}, &configureResp)
+		var schemaResp resource.SchemaResponse
+		r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+		config := configWithValues(t, schemaResp.Schema, map[string]tftypes.Value{
+			"group_id":            tftypes.NewValue(tftypes.String, "group_id"),
+			"service":             tftypes.NewValue(tftypes.String, "postgres"),
+			"rollback_on_failure": tftypes.NewValue(tftypes.Bool, rollback),
+		}, map[string]map[string]tftypes.Value{
+			"destination_schema": {"prefix": tftypes.NewValue(tftypes.String, "postgres_schema")},
+			"wait_for":           {"setup_state_connected": tftypes.NewValue(tftypes.Bool, true)},
+		})
+		resp := resource.CreateResponse{State: nullState(context.Background(), schemaResp.Schema)}
+		r.Create(context.Background(), resource.CreateRequest{Config: config, Plan: tfsdk.Plan{Raw: config.Raw, Schema: schemaResp.Schema}}, &resp)
+
+		assertErrorCount(t, resp.Diagnostics, 1)
+		if summary := resp.Diagnostics.Errors()[0].Summary(); summary != "Connection Setup Is Broken." {
+			t.Errorf("rollback %v: unexpected error: %v", rollback, resp.Diagnostics)
+		}
+		deleted := httpClient.requests[len(httpClient.requests)-1] == "DELETE /v1/connections/connection_id"
+		if deleted != rollback || resp.State.Raw.IsNull() != rollback {
+			t.Errorf("rollback %v: connector deleted = %v, state = %v", rollback, deleted, resp.State.Raw)
+		}
+	}
+}
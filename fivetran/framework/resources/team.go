@@ -7,8 +7,10 @@ import (
     "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
     "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
     fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+    "github.com/hashicorp/terraform-plugin-framework/diag"
     "github.com/hashicorp/terraform-plugin-framework/path"
     "github.com/hashicorp/terraform-plugin-framework/resource"
+    "github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func Team() resource.Resource {
@@ -22,6 +24,7 @@ type team struct {
 // Ensure the implementation satisfies the desired interfaces.
 var _ resource.ResourceWithConfigure = &team{}
 var _ resource.ResourceWithImportState = &team{}
+var _ resource.ResourceWithIdentity = &team{}
 
 func (r *team) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
     resp.TypeName = req.ProviderTypeName + "_team"
@@ -31,8 +34,12 @@ func (r *team) Schema(ctx context.Context, req resource.SchemaRequest, resp *res
     resp.Schema = fivetranSchema.TeamResource()
 }
 
+func (r *team) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+    resp.IdentitySchema = fivetranSchema.IdIdentitySchema("The unique identifier for the team within your account.")
+}
+
 func (r *team) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-    resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+    resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *team) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -45,7 +52,7 @@ func (r *team) Create(ctx context.Context, req resource.CreateRequest, resp *res
 		return
 	}
 
-	var data model.Team
+	var data model.TeamResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -54,6 +61,40 @@ func (r *team) Create(ctx context.Context, req resource.CreateRequest, resp *res
 		return
 	}
 
+	adoptExistingPlan := core.GetBoolOrDefault(data.AdoptExisting, false)
+
+	if adoptExistingPlan {
+		existingId, err := findTeamIdByName(ctx, r.GetClient(), data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Create Team Resource.",
+				fmt.Sprintf("Error while looking up the team to adopt. %v", err),
+			)
+			return
+		}
+		if existingId != "" {
+			readResponse, err := r.GetClient().NewTeamsDetails().TeamId(existingId).Do(ctx)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to Adopt Team Resource.",
+					fmt.Sprintf("%v; code: %v", err, readResponse.Code),
+				)
+				return
+			}
+
+			var existing model.TeamResourceModel
+			existing.ReadFromResponse(ctx, readResponse)
+			if !r.applyUpdate(ctx, data, &existing, &resp.Diagnostics) {
+				return
+			}
+			existing.AdoptExisting = types.BoolValue(adoptExistingPlan)
+
+			resp.Diagnostics.Append(resp.State.Set(ctx, &existing)...)
+			resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentity(existing.Id))...)
+			return
+		}
+	}
+
 	svc := r.GetClient().NewTeamsCreate()
 	svc.Name(data.Name.ValueString())
 	svc.Role(data.Role.ValueString())
@@ -73,8 +114,10 @@ func (r *team) Create(ctx context.Context, req resource.CreateRequest, resp *res
 	}
 
 	data.ReadFromCreateResponse(ctx, createResponse)
+	data.AdoptExisting = types.BoolValue(adoptExistingPlan)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentity(data.Id))...)
 }
 
 func (r *team) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -87,7 +130,7 @@ func (r *team) Read(ctx context.Context, req resource.ReadRequest, resp *resourc
         return
     }
 
-    var data model.Team
+    var data model.TeamResourceModel
 
     // Read Terraform prior state data into the model
     resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -109,6 +152,7 @@ func (r *team) Read(ctx context.Context, req resource.ReadRequest, resp *resourc
     data.ReadFromResponse(ctx, readResponse)
 
     resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+    resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentity(data.Id))...)
 }
 
 func (r *team) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -121,11 +165,23 @@ func (r *team) Update(ctx context.Context, req resource.UpdateRequest, resp *res
         return
     }
 
-    var plan, state model.Team
+    var plan, state model.TeamResourceModel
 
     resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
     resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+    if !r.applyUpdate(ctx, plan, &state, &resp.Diagnostics) {
+        return
+    }
+    state.AdoptExisting = types.BoolValue(core.GetBoolOrDefault(plan.AdoptExisting, false))
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+    resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentity(state.Id))...)
+}
+
+// applyUpdate patches the team in state to the plan and reads the result into the state.
+// Returns false if the team couldn't be updated.
+func (r *team) applyUpdate(ctx context.Context, plan model.TeamResourceModel, state *model.TeamResourceModel, diags *diag.Diagnostics) bool {
     svc := r.GetClient().NewTeamsUpdate().TeamId(state.Id.ValueString())
     
     if !plan.Name.Equal(state.Name) {
@@ -143,16 +199,15 @@ func (r *team) Update(ctx context.Context, req resource.UpdateRequest, resp *res
     updateResponse, err := svc.Do(ctx)
 
     if err != nil {
-        resp.Diagnostics.AddError(
+        diags.AddError(
             "Unable to Update Team Resource.",
             fmt.Sprintf("%v; code: %v; message: %v", err, updateResponse.Code, updateResponse.Message),
         )
-        return
+        return false
     }
 
     state.ReadFromUpdateResponse(ctx, updateResponse)
-
-    resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+    return true
 }
 
 func (r *team) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -165,7 +220,7 @@ func (r *team) Delete(ctx context.Context, req resource.DeleteRequest, resp *res
         return
     }
 
-    var data model.Team
+    var data model.TeamResourceModel
 
     resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
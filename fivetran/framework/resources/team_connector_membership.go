@@ -8,7 +8,6 @@ import (
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
 	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
@@ -24,6 +23,7 @@ type teamConnectorMembership struct {
 // Ensure the implementation satisfies the desired interfaces.
 var _ resource.ResourceWithConfigure = &teamConnectorMembership{}
 var _ resource.ResourceWithImportState = &teamConnectorMembership{}
+var _ resource.ResourceWithIdentity = &teamConnectorMembership{}
 
 func (r *teamConnectorMembership) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_team_connector_membership"
@@ -33,8 +33,13 @@ func (r *teamConnectorMembership) Schema(ctx context.Context, req resource.Schem
 	resp.Schema = fivetranSchema.TeamConnectorMembershipResource()
 }
 
+func (r *teamConnectorMembership) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = fivetranSchema.TeamMembershipIdentitySchema()
+}
+
 func (r *teamConnectorMembership) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	teamId, err := resolveTeamMembershipImport(ctx, r.GetClient(), req, resolveTeamImportId)
+	importResolvedTeamId(ctx, resp, "Unable to Import Team Connector Memberships Resource.", teamId, err)
 }
 
 func (r *teamConnectorMembership) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -87,6 +92,7 @@ func (r *teamConnectorMembership) Create(ctx context.Context, req resource.Creat
 	data.ReadFromResponse(ctx, teamConnectorResponse)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, teamMembershipIdentity(data.TeamId))...)
 }
 
 func (r *teamConnectorMembership) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -119,6 +125,7 @@ func (r *teamConnectorMembership) Read(ctx context.Context, req resource.ReadReq
 	data.ReadFromResponse(ctx, teamConnectorResponse)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, teamMembershipIdentity(data.TeamId))...)
 }
 
 func (r *teamConnectorMembership) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -231,6 +238,7 @@ func (r *teamConnectorMembership) Update(ctx context.Context, req resource.Updat
 	plan.ReadFromResponse(ctx, teamConnectorResponse)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, teamMembershipIdentity(plan.TeamId))...)
 }
 
 func (r *teamConnectorMembership) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -9,7 +9,6 @@ import (
     "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
     "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
     fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
-    "github.com/hashicorp/terraform-plugin-framework/path"
     "github.com/hashicorp/terraform-plugin-framework/resource"
     "github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
@@ -25,6 +24,7 @@ type teamGroupMembership struct {
 // Ensure the implementation satisfies the desired interfaces.
 var _ resource.ResourceWithConfigure = &teamGroupMembership{}
 var _ resource.ResourceWithImportState = &teamGroupMembership{}
+var _ resource.ResourceWithIdentity = &teamGroupMembership{}
 
 func (r *teamGroupMembership) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
     resp.TypeName = req.ProviderTypeName + "_team_group_membership"
@@ -34,8 +34,13 @@ func (r *teamGroupMembership) Schema(ctx context.Context, req resource.SchemaReq
     resp.Schema = fivetranSchema.TeamGroupMembershipResource()
 }
 
+func (r *teamGroupMembership) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+    resp.IdentitySchema = fivetranSchema.TeamMembershipIdentitySchema()
+}
+
 func (r *teamGroupMembership) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-    resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+    teamId, err := resolveTeamMembershipImport(ctx, r.GetClient(), req, resolveTeamGroupMembershipImportId)
+    importResolvedTeamId(ctx, resp, "Unable to Import Team Group Memberships Resource.", teamId, err)
 }
 
 func (r *teamGroupMembership) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -89,6 +94,7 @@ func (r *teamGroupMembership) Create(ctx context.Context, req resource.CreateReq
     data.ReadFromResponse(ctx, teamGroupResponse)
 
     resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+    resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, teamMembershipIdentity(data.TeamId))...)
 }
 
 func (r *teamGroupMembership) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -121,6 +127,7 @@ func (r *teamGroupMembership) Read(ctx context.Context, req resource.ReadRequest
     data.ReadFromResponse(ctx, teamGroupResponse)
 
     resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+    resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, teamMembershipIdentity(data.TeamId))...)
 }
 
 func (r *teamGroupMembership) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -232,6 +239,7 @@ func (r *teamGroupMembership) Update(ctx context.Context, req resource.UpdateReq
     plan.ReadFromResponse(ctx, teamGroupResponse)
 
     resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+    resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, teamMembershipIdentity(plan.TeamId))...)
 }
 
 func (r *teamGroupMembership) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
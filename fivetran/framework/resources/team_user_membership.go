@@ -8,7 +8,6 @@ import (
     "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
     "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
     fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
-    "github.com/hashicorp/terraform-plugin-framework/path"
     "github.com/hashicorp/terraform-plugin-framework/resource"
     "github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
@@ -24,6 +23,7 @@ type teamUserMembership struct {
 // Ensure the implementation satisfies the desired interfaces.
 var _ resource.ResourceWithConfigure = &teamUserMembership{}
 var _ resource.ResourceWithImportState = &teamUserMembership{}
+var _ resource.ResourceWithIdentity = &teamUserMembership{}
 
 func (r *teamUserMembership) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
     resp.TypeName = req.ProviderTypeName + "_team_user_membership"
@@ -33,8 +33,13 @@ func (r *teamUserMembership) Schema(ctx context.Context, req resource.SchemaRequ
     resp.Schema = fivetranSchema.TeamUserMembershipResource()
 }
 
+func (r *teamUserMembership) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+    resp.IdentitySchema = fivetranSchema.TeamMembershipIdentitySchema()
+}
+
 func (r *teamUserMembership) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-    resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+    teamId, err := resolveTeamMembershipImport(ctx, r.GetClient(), req, resolveTeamImportId)
+    importResolvedTeamId(ctx, resp, "Unable to Import Team User Memberships Resource.", teamId, err)
 }
 
 func (r *teamUserMembership) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -89,6 +94,7 @@ func (r *teamUserMembership) Create(ctx context.Context, req resource.CreateRequ
     data.ReadFromResponse(ctx, teamUserResponse)
 
     resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+    resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, teamMembershipIdentity(data.TeamId))...)
 }
 
 func (r *teamUserMembership) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -121,6 +127,7 @@ func (r *teamUserMembership) Read(ctx context.Context, req resource.ReadRequest,
     data.ReadFromResponse(ctx, teamUserResponse)
 
     resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+    resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, teamMembershipIdentity(data.TeamId))...)
 }
 
 func (r *teamUserMembership) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -233,6 +240,7 @@ func (r *teamUserMembership) Update(ctx context.Context, req resource.UpdateRequ
     plan.ReadFromResponse(ctx, teamUserResponse)
 
     resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+    resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, teamMembershipIdentity(plan.TeamId))...)
 }
 
 func (r *teamUserMembership) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -26,6 +26,7 @@ type transformation struct {
 // Ensure the implementation satisfies the desired interfaces.
 var _ resource.ResourceWithConfigure = &transformation{}
 var _ resource.ResourceWithImportState = &transformation{}
+var _ resource.ResourceWithIdentity = &transformation{}
 
 func (r *transformation) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = "fivetran_transformation"
@@ -35,8 +36,12 @@ func (r *transformation) Schema(ctx context.Context, req resource.SchemaRequest,
 	resp.Schema = fivetranSchema.TransformationResource()
 }
 
+func (r *transformation) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = fivetranSchema.IdIdentitySchema("The unique identifier for the transformation within the Fivetran system.")
+}
+
 func (r *transformation) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *transformation) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -255,6 +260,7 @@ func (r *transformation) Create(ctx context.Context, req resource.CreateRequest,
 	data.ReadFromResponse(ctx, createResponse)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentity(data.Id))...)
 
 	if resp.Diagnostics.HasError() {
 		// Do cleanup on error
@@ -304,6 +310,7 @@ func (r *transformation) Read(ctx context.Context, req resource.ReadRequest, res
 	data.ReadFromResponse(ctx, readResponse)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentity(data.Id))...)
 }
 
 func (r *transformation) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -520,6 +527,7 @@ func (r *transformation) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentity(plan.Id))...)
 }
 
 func (r *transformation) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
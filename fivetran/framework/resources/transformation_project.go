@@ -25,6 +25,7 @@ type transformationProject struct {
 // Ensure the implementation satisfies the desired interfaces.
 var _ resource.ResourceWithConfigure = &transformationProject{}
 var _ resource.ResourceWithImportState = &transformationProject{}
+var _ resource.ResourceWithIdentity = &transformationProject{}
 
 func (r *transformationProject) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = "fivetran_transformation_project"
@@ -34,8 +35,12 @@ func (r *transformationProject) Schema(ctx context.Context, req resource.SchemaR
 	resp.Schema = fivetranSchema.TransformationProjectResource(ctx)
 }
 
+func (r *transformationProject) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = fivetranSchema.IdIdentitySchema("The unique identifier for the transformation project within the Fivetran system.")
+}
+
 func (r *transformationProject) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *transformationProject) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -118,6 +123,7 @@ func (r *transformationProject) Create(ctx context.Context, req resource.CreateR
 	data.ReadFromResponse(ctx, projectResponse)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentity(data.Id))...)
 
 	if resp.Diagnostics.HasError() {
 		// Do cleanup on error
@@ -167,6 +173,7 @@ func (r *transformationProject) Read(ctx context.Context, req resource.ReadReque
 	data.ReadFromResponse(ctx, projectResponse)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentity(data.Id))...)
 }
 
 func (r *transformationProject) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -265,6 +272,7 @@ func (r *transformationProject) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentity(plan.Id))...)
 }
 
 func (r *transformationProject) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
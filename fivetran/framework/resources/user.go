@@ -7,9 +7,10 @@ import (
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
 	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func User() resource.Resource {
@@ -23,6 +24,7 @@ type user struct {
 // Ensure the implementation satisfies the desired interfaces.
 var _ resource.ResourceWithConfigure = &user{}
 var _ resource.ResourceWithImportState = &user{}
+var _ resource.ResourceWithIdentity = &user{}
 
 func (r *user) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_user"
@@ -34,8 +36,13 @@ func (r *user) Schema(ctx context.Context, req resource.SchemaRequest, resp *res
 	}
 }
 
+func (r *user) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = fivetranSchema.IdIdentitySchema("The unique identifier for the user within the Fivetran system.")
+}
+
 func (r *user) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id, err := resolveImportId(ctx, r.GetClient(), req, resolveUserImportId)
+	importResolvedId(ctx, resp, "Unable to Import User Resource.", id, err, "id")
 }
 
 func (r *user) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -48,7 +55,7 @@ func (r *user) Create(ctx context.Context, req resource.CreateRequest, resp *res
 		return
 	}
 
-	var data model.User
+	var data model.UserResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -57,6 +64,50 @@ func (r *user) Create(ctx context.Context, req resource.CreateRequest, resp *res
 		return
 	}
 
+	adoptExistingPlan := core.GetBoolOrDefault(data.AdoptExisting, false)
+
+	if adoptExistingPlan {
+		existingId, err := findUserIdByEmail(ctx, r.GetClient(), data.Email.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Create User Resource.",
+				fmt.Sprintf("Error while looking up the user to adopt. %v", err),
+			)
+			return
+		}
+		if existingId != "" {
+			userResponse, err := r.GetClient().NewUserDetails().UserID(existingId).Do(ctx)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to Adopt User Resource.",
+					fmt.Sprintf("%v; code: %v; message: %v", err, userResponse.Code, userResponse.Message),
+				)
+				return
+			}
+
+			var existing model.UserResourceModel
+			existing.ReadFromResponse(userResponse)
+			// unconfigured optional values are unknown in the plan, the adopted user keeps them
+			if data.Role.IsUnknown() {
+				data.Role = existing.Role
+			}
+			if data.Picture.IsUnknown() {
+				data.Picture = existing.Picture
+			}
+			if data.Phone.IsUnknown() {
+				data.Phone = existing.Phone
+			}
+			if !r.applyUpdate(ctx, data, &existing, &resp.Diagnostics) {
+				return
+			}
+			existing.AdoptExisting = types.BoolValue(adoptExistingPlan)
+
+			resp.Diagnostics.Append(resp.State.Set(ctx, &existing)...)
+			resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentity(existing.ID))...)
+			return
+		}
+	}
+
 	svc := r.GetClient().NewUserInvite()
 	svc.Email(data.Email.ValueString())
 	svc.GivenName(data.GivenName.ValueString())
@@ -85,8 +136,10 @@ func (r *user) Create(ctx context.Context, req resource.CreateRequest, resp *res
 	}
 
 	data.ReadFromResponse(userResponse)
+	data.AdoptExisting = types.BoolValue(adoptExistingPlan)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentity(data.ID))...)
 }
 
 func (r *user) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -99,7 +152,7 @@ func (r *user) Read(ctx context.Context, req resource.ReadRequest, resp *resourc
 		return
 	}
 
-	var data model.User
+	var data model.UserResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -120,6 +173,7 @@ func (r *user) Read(ctx context.Context, req resource.ReadRequest, resp *resourc
 
 	data.ReadFromResponse(userResponse)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentity(data.ID))...)
 }
 
 func (r *user) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -132,11 +186,23 @@ func (r *user) Update(ctx context.Context, req resource.UpdateRequest, resp *res
 		return
 	}
 
-	var plan, state model.User
+	var plan, state model.UserResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	if !r.applyUpdate(ctx, plan, &state, &resp.Diagnostics) {
+		return
+	}
+	state.AdoptExisting = types.BoolValue(core.GetBoolOrDefault(plan.AdoptExisting, false))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentity(state.ID))...)
+}
+
+// applyUpdate patches the user in state to the plan and reads the result into the state.
+// Returns false if the user couldn't be updated.
+func (r *user) applyUpdate(ctx context.Context, plan model.UserResourceModel, state *model.UserResourceModel, diags *diag.Diagnostics) bool {
 	svc := r.GetClient().NewUserUpdate().UserID(state.ID.ValueString())
 
 	if !plan.FamilyName.Equal(state.FamilyName) {
@@ -169,16 +235,15 @@ func (r *user) Update(ctx context.Context, req resource.UpdateRequest, resp *res
 	userResponse, err := svc.Do(ctx)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		diags.AddError(
 			"Unable to Update User Resource.",
 			fmt.Sprintf("%v; code: %v; message: %v", err, userResponse.Code, userResponse.Message),
 		)
-		return
+		return false
 	}
 
 	state.ReadFromResponse(userResponse)
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	return true
 }
 
 func (r *user) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -191,7 +256,7 @@ func (r *user) Delete(ctx context.Context, req resource.DeleteRequest, resp *res
 		return
 	}
 
-	var data model.User
+	var data model.UserResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
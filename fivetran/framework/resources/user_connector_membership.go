@@ -8,7 +8,6 @@ import (
     "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
     "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
     fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
-    "github.com/hashicorp/terraform-plugin-framework/path"
     "github.com/hashicorp/terraform-plugin-framework/resource"
     "github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
@@ -34,7 +33,8 @@ func (r *userConnectorMembership) Schema(ctx context.Context, req resource.Schem
 }
 
 func (r *userConnectorMembership) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-    resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+    id, err := resolveUserImportId(ctx, r.GetClient(), req.ID)
+    importResolvedId(ctx, resp, "Unable to Import User Connector Memberships Resource.", id, err, "user_id")
 }
 
 func (r *userConnectorMembership) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
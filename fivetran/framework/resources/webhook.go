@@ -23,6 +23,7 @@ type webhook struct {
 // Ensure the implementation satisfies the desired interfaces.
 var _ resource.ResourceWithConfigure = &webhook{}
 var _ resource.ResourceWithImportState = &webhook{}
+var _ resource.ResourceWithIdentity = &webhook{}
 
 func (r *webhook) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
     resp.TypeName = req.ProviderTypeName + "_webhook"
@@ -32,8 +33,12 @@ func (r *webhook) Schema(ctx context.Context, req resource.SchemaRequest, resp *
     resp.Schema = fivetranSchema.WebhookResource()
 }
 
+func (r *webhook) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+    resp.IdentitySchema = fivetranSchema.IdIdentitySchema("The webhook ID")
+}
+
 func (r *webhook) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-    resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+    resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 
@@ -115,6 +120,7 @@ func (r *webhook) createAccount(ctx context.Context, data model.Webhook, resp *r
     }
 
     resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+    resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentity(data.Id))...)
 }
 
 func (r *webhook) createGroup(ctx context.Context, data model.Webhook, resp *resource.CreateResponse) {
@@ -165,6 +171,7 @@ func (r *webhook) createGroup(ctx context.Context, data model.Webhook, resp *res
     }
 
     resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+    resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentity(data.Id))...)
 }
 
 func (r *webhook) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -199,6 +206,7 @@ func (r *webhook) Read(ctx context.Context, req resource.ReadRequest, resp *reso
     data.ReadFromResponse(ctx, webhookResponse)
 
     resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+    resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentity(data.Id))...)
 }
 
 func (r *webhook) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -284,6 +292,7 @@ func (r *webhook) Update(ctx context.Context, req resource.UpdateRequest, resp *
     }
 
     resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+    resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentity(state.Id))...)
 }
 
 func (r *webhook) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
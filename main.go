@@ -4,7 +4,9 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/export"
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 )
@@ -27,6 +29,13 @@ func main() {
 	// if err != nil {
 	// 	log.Fatal(err.Error())
 	// }
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := export.Run(os.Args[2:]); err != nil {
+			log.Fatalf("%v", err)
+		}
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
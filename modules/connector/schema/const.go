@@ -11,6 +11,9 @@ const (
 	HISTORY       = "HISTORY"
 	LIVE          = "LIVE"
 
+	GLOB  = "GLOB"
+	REGEX = "REGEX"
+
 	SCHEMA_CHANGE_HANDLING = "schema_change_handling"
 	SCHEMA                 = "schema"
 	TABLE                  = "table"
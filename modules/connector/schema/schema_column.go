@@ -11,15 +11,18 @@ import (
 
 type _column struct {
 	_element
-	hashed *bool
+	hashed        *bool
+	hashedPatched bool // indicates that the patch changes column hashing
 }
 
 func (c *_column) setHashed(value *bool) {
 	if value != nil && (c.hashed == nil || *value != *c.hashed) {
 		c.hashed = value
 		c.updated = true
+		c.hashedPatched = true
 	} else {
 		c.hashed = nil
+		c.hashedPatched = false
 	}
 }
 
@@ -155,7 +158,7 @@ func (c _column) toStateObject(sch string, local *_column, diag *diag.Diagnostic
 	}
 	
 	include :=local != nil ||
-		(c.enabled != (sch != BLOCK_ALL) && c.isPatchAllowed()) ||
+		(c.enabled != (sch != BLOCK_ALL) && c.isPatchAllowed() && !c.ruleManaged) ||
 		isImporting
 	return result, include
 }
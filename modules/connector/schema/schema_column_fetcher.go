@@ -0,0 +1,140 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/connections"
+	"github.com/fivetran/terraform-provider-fivetran/modules/helpers"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const DefaultColumnFetchConcurrency = 4
+
+type _columns = map[string]*connections.ConnectionSchemaConfigColumnResponse
+
+type _tableKey struct {
+	schema string
+	table  string
+}
+
+type _columnRequest struct {
+	_tableKey
+	required []string
+}
+
+// ColumnFetcher fetches table columns for schema validation with a bounded worker pool.
+// Fetched columns are cached per connection, so repeated validations within one apply don't fetch them again.
+type ColumnFetcher struct {
+	concurrency int
+	mutex       sync.Mutex
+	cache       map[string]map[_tableKey]_columns
+}
+
+func NewColumnFetcher(concurrency int) *ColumnFetcher {
+	if concurrency < 1 {
+		concurrency = DefaultColumnFetchConcurrency
+	}
+	return &ColumnFetcher{
+		concurrency: concurrency,
+		cache:       make(map[string]map[_tableKey]_columns),
+	}
+}
+
+// Invalidate drops cached columns of the connection, should be called once its schema config is changed.
+func (f *ColumnFetcher) Invalidate(connectorId string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	delete(f.cache, connectorId)
+}
+
+func (f *ColumnFetcher) getCached(connectorId string, request _columnRequest) (_columns, bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	columns, ok := f.cache[connectorId][request._tableKey]
+	if !ok {
+		return nil, false
+	}
+	// the column might appear in source after the columns were cached
+	for _, name := range request.required {
+		if _, ok := columns[name]; !ok {
+			return nil, false
+		}
+	}
+	return columns, true
+}
+
+func (f *ColumnFetcher) setCached(connectorId string, key _tableKey, columns _columns) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if _, ok := f.cache[connectorId]; !ok {
+		f.cache[connectorId] = make(map[_tableKey]_columns)
+	}
+	f.cache[connectorId][key] = columns
+}
+
+// fetch returns columns of requested tables, fetching the tables that are not cached with up to `concurrency` parallel requests.
+// The first failed request cancels the remaining ones.
+func (f *ColumnFetcher) fetch(ctx context.Context, client fivetran.Client, connectorId string, requests []_columnRequest) (map[_tableKey]_columns, error) {
+	result := make(map[_tableKey]_columns)
+	pending := make([]_columnRequest, 0)
+	for _, r := range requests {
+		if columns, ok := f.getCached(connectorId, r); ok {
+			result[r._tableKey] = columns
+		} else {
+			pending = append(pending, r)
+		}
+	}
+	if len(pending) == 0 {
+		return result, nil
+	}
+
+	workers := f.concurrency
+	if workers > len(pending) {
+		workers = len(pending)
+	}
+	tflog.Info(ctx, "Fetching table columns for schema validation", map[string]interface{}{
+		"connection_id": connectorId,
+		"tables":        len(pending),
+		"cached_tables": len(result),
+		"workers":       workers,
+	})
+
+	var (
+		resultMutex sync.Mutex
+		fetched     int
+	)
+	progressStep := len(pending) / 10
+	if progressStep == 0 {
+		progressStep = 1
+	}
+
+	err := helpers.ForEachBounded(ctx, workers, len(pending), func(ctx context.Context, i int) error {
+		r := pending[i]
+		response, err := client.NewConnectionColumnConfigListService().ConnectionId(connectorId).Schema(r.schema).Table(r.table).Do(ctx)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving columns config for table `%s` of schema `%s. Error: %v; Code: `%v`.",
+				r.table, r.schema, err, response.Code)
+		}
+
+		resultMutex.Lock()
+		defer resultMutex.Unlock()
+		result[r._tableKey] = response.Data.Columns
+		f.setCached(connectorId, r._tableKey, response.Data.Columns)
+		fetched++
+		if fetched%progressStep == 0 || fetched == len(pending) {
+			tflog.Info(ctx, "Fetched table columns", map[string]interface{}{
+				"connection_id": connectorId,
+				"fetched":       fetched,
+				"total":         len(pending),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,164 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/connections"
+)
+
+// columnsHTTPClient serves column lists of any table and tracks the number of requests in flight.
+type columnsHTTPClient struct {
+	columns  string
+	status   int
+	requests atomic.Int32
+	inFlight atomic.Int32
+	maxMutex sync.Mutex
+	max      int32
+}
+
+func (c *columnsHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	c.requests.Add(1)
+	current := c.inFlight.Add(1)
+	defer c.inFlight.Add(-1)
+
+	c.maxMutex.Lock()
+	if current > c.max {
+		c.max = current
+	}
+	c.maxMutex.Unlock()
+	time.Sleep(5 * time.Millisecond)
+
+	status := c.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(fmt.Sprintf(`{"code":"Success","data":{"columns":%v}}`, c.columns))),
+	}, nil
+}
+
+func columnsClient(httpClient *columnsHTTPClient) fivetran.Client {
+	client := fivetran.New("key", "secret")
+	client.SetHttpClient(httpClient)
+	return *client
+}
+
+func upstreamSchemas(tables int) map[string]*connections.ConnectionSchemaConfigSchemaResponse {
+	result := &connections.ConnectionSchemaConfigSchemaResponse{
+		Enabled: boolPtr(true),
+		Tables:  map[string]*connections.ConnectionSchemaConfigTableResponse{},
+	}
+	for i := 0; i < tables; i++ {
+		result.Tables[fmt.Sprintf("table_%v", i)] = upstreamTable(true, nil)
+	}
+	return map[string]*connections.ConnectionSchemaConfigSchemaResponse{"public": result}
+}
+
+func localColumnsConfig(tables int, column string) SchemaConfig {
+	tableList := []interface{}{}
+	for i := 0; i < tables; i++ {
+		tableList = append(tableList, map[string]interface{}{
+			NAME:   fmt.Sprintf("table_%v", i),
+			COLUMN: []interface{}{map[string]interface{}{NAME: column, ENABLED: false}},
+		})
+	}
+	return localConfig([]interface{}{map[string]interface{}{NAME: "public", TABLE: tableList}})
+}
+
+func TestValidateSchemasFetchesColumnsInParallel(t *testing.T) {
+	httpClient := &columnsHTTPClient{columns: `{"id":{"enabled":true},"email":{"enabled":true}}`}
+	client := columnsClient(httpClient)
+	upstream := upstreamSchemas(12)
+	local := localColumnsConfig(12, "email")
+
+	err, needReload := local.ValidateSchemas("connection_id", upstream, client, context.Background(), true, NewColumnFetcher(3))
+	if err != nil || needReload {
+		t.Fatalf("ValidateSchemas: %v, needReload: %v", err, needReload)
+	}
+	if httpClient.requests.Load() != 12 {
+		t.Errorf("requests = %v, want 12", httpClient.requests.Load())
+	}
+	if httpClient.max > 3 || httpClient.max < 2 {
+		t.Errorf("max requests in flight = %v, want between 2 and 3", httpClient.max)
+	}
+	if len(upstream["public"].Tables["table_5"].Columns) != 2 {
+		t.Errorf("fetched columns should be set into upstream config")
+	}
+}
+
+func TestValidateSchemasReusesFetchedColumns(t *testing.T) {
+	httpClient := &columnsHTTPClient{columns: `{"email":{"enabled":true}}`}
+	client := columnsClient(httpClient)
+	fetcher := NewColumnFetcher(2)
+	local := localColumnsConfig(4, "email")
+
+	for i := 0; i < 2; i++ {
+		if err, _ := local.ValidateSchemas("connection_id", upstreamSchemas(4), client, context.Background(), true, fetcher); err != nil {
+			t.Fatalf("ValidateSchemas: %v", err)
+		}
+	}
+	if httpClient.requests.Load() != 4 {
+		t.Errorf("requests = %v, want 4: columns should be fetched once", httpClient.requests.Load())
+	}
+
+	fetcher.Invalidate("connection_id")
+	if err, _ := local.ValidateSchemas("connection_id", upstreamSchemas(4), client, context.Background(), true, fetcher); err != nil {
+		t.Fatalf("ValidateSchemas: %v", err)
+	}
+	if httpClient.requests.Load() != 8 {
+		t.Errorf("requests = %v, want 8: invalidated columns should be fetched again", httpClient.requests.Load())
+	}
+}
+
+func TestValidateSchemasReportsMissingColumn(t *testing.T) {
+	httpClient := &columnsHTTPClient{columns: `{"id":{"enabled":true}}`}
+	local := localColumnsConfig(1, "email")
+
+	err, needReload := local.ValidateSchemas("connection_id", upstreamSchemas(1), columnsClient(httpClient), context.Background(), true, NewColumnFetcher(2))
+	if err == nil || needReload {
+		t.Fatalf("expected missing column error, got: %v, needReload: %v", err, needReload)
+	}
+	if !strings.Contains(err.Error(), "Column `email` of table with name `table_0` not found") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestValidateSchemasStopsOnFetchError(t *testing.T) {
+	httpClient := &columnsHTTPClient{columns: `{}`, status: http.StatusNotFound}
+	local := localColumnsConfig(20, "email")
+
+	err, _ := local.ValidateSchemas("connection_id", upstreamSchemas(20), columnsClient(httpClient), context.Background(), true, NewColumnFetcher(2))
+	if err == nil || !strings.Contains(err.Error(), "Error while retrieving columns config") {
+		t.Fatalf("expected fetch error, got: %v", err)
+	}
+	if httpClient.requests.Load() >= 20 {
+		t.Errorf("requests = %v, remaining requests should be cancelled after the first error", httpClient.requests.Load())
+	}
+}
+
+func TestValidateSchemasFetchesColumnsForColumnRules(t *testing.T) {
+	httpClient := &columnsHTTPClient{columns: `{"email":{"enabled":true}}`}
+	local := localConfig(nil, Rule{MatchType: REGEX, Table: "^table_[01]$", Column: "email", Hashed: boolPtr(true)})
+	upstream := upstreamSchemas(5)
+
+	if err, _ := local.ValidateSchemas("connection_id", upstream, columnsClient(httpClient), context.Background(), true, nil); err != nil {
+		t.Fatalf("ValidateSchemas: %v", err)
+	}
+	if httpClient.requests.Load() != 2 {
+		t.Errorf("requests = %v, want 2", httpClient.requests.Load())
+	}
+	if len(upstream["public"].Tables["table_1"].Columns) != 1 || len(upstream["public"].Tables["table_2"].Columns) != 0 {
+		t.Errorf("columns should be fetched only for tables targeted by column rules")
+	}
+}
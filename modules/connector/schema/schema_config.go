@@ -11,24 +11,58 @@ import (
 
 type SchemaConfig struct {
 	schemas map[string]*_schema
+	rules   []Rule
 }
 
+// ValidateSchemas checks that configured schemas and tables exist in upstream. If validateColumns is set,
+// columns of configured tables and tables targeted by column rules are fetched with the fetcher into upstream config.
 func (c SchemaConfig) ValidateSchemas(
 	connectorId string,
 	schemas map[string]*connections.ConnectionSchemaConfigSchemaResponse, //upstream
 	client fivetran.Client,
 	ctx context.Context,
-	validateColumns bool) (error, bool) {
+	validateColumns bool,
+	fetcher *ColumnFetcher) (error, bool) {
+	requests := make([]_columnRequest, 0)
 	for sName, schema := range c.schemas {
 		if responseSchema, ok := schemas[sName]; ok {
-			err, needReload := schema.validateTables(connectorId, sName, responseSchema, client, ctx, validateColumns)
+			schemaRequests, err, needReload := schema.validateTables(sName, responseSchema, validateColumns)
 			if err != nil {
 				return err, needReload
 			}
+			requests = append(requests, schemaRequests...)
 		} else {
 			return fmt.Errorf("Schema with name `%s` not found in source.", sName), true
 		}
 	}
+	if !validateColumns {
+		return nil, false
+	}
+
+	ruleRequests, err := c.ruleColumnRequests(schemas)
+	if err != nil {
+		return err, false
+	}
+	requests = mergeColumnRequests(requests, ruleRequests)
+	if len(requests) == 0 {
+		return nil, false
+	}
+
+	if fetcher == nil {
+		fetcher = NewColumnFetcher(DefaultColumnFetchConcurrency)
+	}
+	columns, err := fetcher.fetch(ctx, client, connectorId, requests)
+	if err != nil {
+		return err, false
+	}
+	for _, r := range requests {
+		schemas[r.schema].Tables[r.table].Columns = columns[r._tableKey]
+		for _, cName := range r.required {
+			if _, ok := columns[r._tableKey][cName]; !ok {
+				return fmt.Errorf("Column `%v` of table with name `%s` not found in source schema `%s`.", cName, r.table, r.schema), false
+			}
+		}
+	}
 	return nil, false
 }
 
@@ -55,6 +89,11 @@ func (c SchemaConfig) PrepareCreateRequest(svc *connections.ConnectionSchemaConf
 	return svc
 }
 func (c *SchemaConfig) Override(local *SchemaConfig, sch string) error {
+	// explicitly configured elements take precedence over rules
+	local, err := c.applyRules(local, sch)
+	if err != nil {
+		return err
+	}
 	if local != nil {
 		for sName, s := range c.schemas {
 			if lSchema, ok := local.schemas[sName]; ok {
@@ -105,6 +144,11 @@ func (c *SchemaConfig) ReadFromResponse(response connections.ConnectionSchemaDet
 func (c SchemaConfig) GetSchemas(sch string, local SchemaConfig, isImporting bool, diag *diag.Diagnostics) []interface{} {
 	schemas := make([]interface{}, 0)
 
+	// elements aligned by rules are not listed in state, rules are kept in state as configured
+	if effects, err := c.ResolveRules(local); err == nil {
+		c.markRuleManaged(effects)
+	}
+
 	for k, v := range c.schemas {
 		var schemaState map[string]interface{}
 		var include bool
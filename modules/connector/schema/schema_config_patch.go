@@ -0,0 +1,126 @@
+package schema
+
+import (
+	"sort"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/connections"
+)
+
+// SchemaConfigPatch is a part of schema config patch that is applied with a single update request.
+type SchemaConfigPatch struct {
+	Schemas []string
+	Tables  int
+	schemas map[string]*connections.ConnectionSchemaConfigSchema
+}
+
+func (p SchemaConfigPatch) PrepareRequest(svc *connections.ConnectionSchemaConfigUpdateService) *connections.ConnectionSchemaConfigUpdateService {
+	for k, v := range p.schemas {
+		svc.Schema(k, v)
+	}
+	return svc
+}
+
+// SplitPatch splits updated elements into patches with at most maxTables updated tables each.
+// A schema may be split between several patches, its own settings are sent with the first one.
+// If maxTables is not positive the whole config is patched with a single request, same as PrepareRequest.
+func (c SchemaConfig) SplitPatch(maxTables int) []SchemaConfigPatch {
+	result := make([]SchemaConfigPatch, 0)
+	current := newSchemaConfigPatch()
+	add := func(name string, schema *connections.ConnectionSchemaConfigSchema) {
+		current.schemas[name] = schema
+		current.Schemas = append(current.Schemas, name)
+	}
+	flush := func() {
+		if len(current.schemas) > 0 {
+			result = append(result, current)
+		}
+		current = newSchemaConfigPatch()
+	}
+
+	for _, sName := range sortedKeys(c.schemas) {
+		s := c.schemas[sName]
+		if !s.updated {
+			continue
+		}
+		schemaRequest := fivetran.NewConnectionSchemaConfigSchema()
+		if s.enabledPatched {
+			schemaRequest.Enabled(s.enabled)
+		}
+		schemaRequestEmpty := !s.enabledPatched
+		for _, tName := range sortedKeys(s.tables) {
+			t := s.tables[tName]
+			if !t.updated {
+				continue
+			}
+			if maxTables > 0 && current.Tables >= maxTables {
+				if !schemaRequestEmpty {
+					add(sName, schemaRequest)
+				}
+				flush()
+				schemaRequest = fivetran.NewConnectionSchemaConfigSchema()
+				schemaRequestEmpty = true
+			}
+			schemaRequest.Table(tName, t.prepareRequest())
+			schemaRequestEmpty = false
+			current.Tables++
+		}
+		if !schemaRequestEmpty {
+			add(sName, schemaRequest)
+		}
+	}
+	flush()
+	return result
+}
+
+// SchemaTable identifies a table within the schema config.
+type SchemaTable struct {
+	Schema string
+	Table  string
+}
+
+// TablesToResync returns updated tables whose sync mode or column hashing is changed by the patch,
+// such tables need a resync to make the destination data consistent. Tables are sorted by schema and table name.
+func (c SchemaConfig) TablesToResync() []SchemaTable {
+	result := make([]SchemaTable, 0)
+	for _, sName := range sortedKeys(c.schemas) {
+		s := c.schemas[sName]
+		if !s.updated {
+			continue
+		}
+		for _, tName := range sortedKeys(s.tables) {
+			if t := s.tables[tName]; t.updated && t.resyncRequired() {
+				result = append(result, SchemaTable{Schema: sName, Table: tName})
+			}
+		}
+	}
+	return result
+}
+
+func (t _table) resyncRequired() bool {
+	if t.syncMode != nil {
+		return true
+	}
+	for _, c := range t.columns {
+		if c.updated && c.hashedPatched {
+			return true
+		}
+	}
+	return false
+}
+
+func newSchemaConfigPatch() SchemaConfigPatch {
+	return SchemaConfigPatch{
+		Schemas: make([]string, 0),
+		schemas: make(map[string]*connections.ConnectionSchemaConfigSchema),
+	}
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	result := make([]string, 0, len(m))
+	for k := range m {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+	return result
+}
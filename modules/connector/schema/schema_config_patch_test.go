@@ -0,0 +1,117 @@
+package schema
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/fivetran/go-fivetran/connections"
+)
+
+// patchedConfig returns the config with `tables` tables of the disabled upstream schema `public` disabled locally,
+// so the schema enabled flag and all the tables are patched.
+func patchedConfig(t *testing.T, tables int) SchemaConfig {
+	t.Helper()
+	response := connections.ConnectionSchemaDetailsResponse{}
+	response.Data.SchemaChangeHandling = ALLOW_ALL
+	response.Data.Schemas = map[string]*connections.ConnectionSchemaConfigSchemaResponse{
+		"public": {
+			Enabled: boolPtr(false),
+			Tables:  map[string]*connections.ConnectionSchemaConfigTableResponse{},
+		},
+	}
+	tableList := []interface{}{}
+	for i := 0; i < tables; i++ {
+		name := fmt.Sprintf("table_%v", i)
+		response.Data.Schemas["public"].Tables[name] = upstreamTable(true, nil)
+		tableList = append(tableList, map[string]interface{}{NAME: name, ENABLED: false})
+	}
+	upstream := SchemaConfig{}
+	upstream.ReadFromResponse(response)
+
+	local := localConfig([]interface{}{map[string]interface{}{NAME: "public", ENABLED: true, TABLE: tableList}})
+	if err := upstream.Override(&local, ALLOW_ALL); err != nil {
+		t.Fatalf("Override: %v", err)
+	}
+	return upstream
+}
+
+func TestSplitPatchChunksTables(t *testing.T) {
+	patches := patchedConfig(t, 5).SplitPatch(2)
+	if len(patches) != 3 {
+		t.Fatalf("patches = %v, want 3", len(patches))
+	}
+
+	expected := [][]string{{"table_0", "table_1"}, {"table_2", "table_3"}, {"table_4"}}
+	for i, patch := range patches {
+		if !reflect.DeepEqual(patch.Schemas, []string{"public"}) {
+			t.Errorf("patch %v schemas = %v", i, patch.Schemas)
+		}
+		if patch.Tables != len(expected[i]) {
+			t.Errorf("patch %v tables = %v, want %v", i, patch.Tables, len(expected[i]))
+		}
+		request := patch.schemas["public"].Request()
+		for _, name := range expected[i] {
+			if request.Tables[name] == nil || request.Tables[name].Enabled == nil || *request.Tables[name].Enabled {
+				t.Errorf("patch %v should disable %v: %v", i, name, request.Tables[name])
+			}
+		}
+		// schema settings are sent only once, with the first request
+		if (request.Enabled != nil) != (i == 0) {
+			t.Errorf("patch %v schema enabled = %v", i, request.Enabled)
+		}
+	}
+}
+
+func TestSplitPatchWithoutLimit(t *testing.T) {
+	config := patchedConfig(t, 5)
+	for _, maxTables := range []int{0, 5, 10} {
+		patches := config.SplitPatch(maxTables)
+		if len(patches) != 1 || patches[0].Tables != 5 {
+			t.Errorf("SplitPatch(%v) should return a single patch with 5 tables, got %v", maxTables, patches)
+		}
+	}
+
+	if patches := (SchemaConfig{}).SplitPatch(2); len(patches) != 0 {
+		t.Errorf("config without updates should not produce patches, got %v", patches)
+	}
+}
+
+func TestTablesToResync(t *testing.T) {
+	orders := upstreamTable(true, nil)
+	orders.SyncMode = stringPtr(SOFT_DELETE)
+	logs := upstreamTable(true, nil)
+	logs.SyncMode = stringPtr(HISTORY)
+	upstream := upstreamConfig(map[string]*connections.ConnectionSchemaConfigTableResponse{
+		"orders":   orders,
+		"logs":     logs,
+		"users":    upstreamTable(true, map[string]bool{"email": true, "id": true}),
+		"events":   upstreamTable(true, nil),
+		"payments": upstreamTable(true, nil),
+		"tokens":   upstreamTable(true, nil),
+	})
+	local := localConfig([]interface{}{map[string]interface{}{
+		NAME:    "public",
+		ENABLED: true,
+		TABLE: []interface{}{
+			configuredTable("orders", map[string]interface{}{SYNC_MODE: HISTORY}),
+			configuredTable("logs", map[string]interface{}{SYNC_MODE: HISTORY}),
+			configuredTable("users", nil, map[string]interface{}{NAME: "email", HASHED: true}),
+			configuredTable("events", map[string]interface{}{ENABLED: false}),
+			configuredTable("payments", nil, map[string]interface{}{NAME: "card", HASHED: false}),
+			configuredTable("tokens", nil, map[string]interface{}{NAME: "token", HASHED: true}),
+		},
+	}})
+	if err := upstream.Override(&local, ALLOW_ALL); err != nil {
+		t.Fatalf("Override: %v", err)
+	}
+
+	expected := []SchemaTable{
+		{Schema: "public", Table: "orders"},
+		{Schema: "public", Table: "tokens"},
+		{Schema: "public", Table: "users"},
+	}
+	if tables := upstream.TablesToResync(); !reflect.DeepEqual(tables, expected) {
+		t.Errorf("TablesToResync() = %v, want %v", tables, expected)
+	}
+}
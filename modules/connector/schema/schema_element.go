@@ -9,6 +9,7 @@ type _element struct {
 	patchAllowed   *bool
 	lockReason     *string
 	enabledPatched bool // indicates that we need to include new value in request
+	ruleManaged    bool // indicates that the element is aligned by rules instead of schema change handling policy
 }
 
 func (e *_element) isPatchAllowed() bool {
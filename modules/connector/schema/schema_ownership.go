@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"sort"
+	"sync"
+)
+
+// TableOwnership tracks connections managed by schema config resources and tables managed by schema table resources
+// planned with one provider instance, so the resources can detect that the same table is managed twice.
+type TableOwnership struct {
+	mutex         sync.Mutex
+	schemaConfigs map[string]map[_tableKey]bool
+	tables        map[string]map[_tableKey]bool
+}
+
+func NewTableOwnership() *TableOwnership {
+	return &TableOwnership{
+		schemaConfigs: make(map[string]map[_tableKey]bool),
+		tables:        make(map[string]map[_tableKey]bool),
+	}
+}
+
+// ClaimSchemaConfig registers the connection schema config with its explicitly configured tables
+// and returns the tables of the connection claimed by schema table resources.
+// Schemas are given in resource data format.
+func (o *TableOwnership) ClaimSchemaConfig(connectorId string, schemas []interface{}) (explicit, aligned []string) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	configured := make(map[_tableKey]bool)
+	for _, si := range schemas {
+		sMap, ok := si.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		sName, _ := sMap[NAME].(string)
+		for _, ti := range getTables(sMap) {
+			if tMap, ok := ti.(map[string]interface{}); ok {
+				tName, _ := tMap[NAME].(string)
+				configured[_tableKey{schema: sName, table: tName}] = true
+			}
+		}
+	}
+	o.schemaConfigs[connectorId] = configured
+
+	for key := range o.tables[connectorId] {
+		if configured[key] {
+			explicit = append(explicit, rulePath(key.schema, key.table))
+		} else {
+			aligned = append(aligned, rulePath(key.schema, key.table))
+		}
+	}
+	sort.Strings(explicit)
+	sort.Strings(aligned)
+	return explicit, aligned
+}
+
+// ClaimTable registers the table managed by a schema table resource. Returns whether the connection is managed
+// by a schema config resource and whether the table is configured in it explicitly.
+func (o *TableOwnership) ClaimTable(connectorId, schema, table string) (managed, explicit bool) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	key := _tableKey{schema: schema, table: table}
+	if _, ok := o.tables[connectorId]; !ok {
+		o.tables[connectorId] = make(map[_tableKey]bool)
+	}
+	o.tables[connectorId][key] = true
+
+	configured, managed := o.schemaConfigs[connectorId]
+	return managed, configured[key]
+}
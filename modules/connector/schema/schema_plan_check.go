@@ -0,0 +1,124 @@
+package schema
+
+import (
+	"fmt"
+	"sort"
+)
+
+// CheckConfiguredElements verifies explicitly configured element values against the upstream config, so the changes
+// that can't be applied are reported in plan instead of failing the apply after a partial patch:
+// locked tables and columns can't change their `enabled` value, primary key columns can't become disabled or hashed.
+// Schemas are given in resource data format, values that are not configured or not known yet should be omitted.
+// Rules are checked as well: they skip locked elements, but shouldn't disable or hash primary key columns.
+func (c SchemaConfig) CheckConfiguredElements(schemas []interface{}, rules []Rule) []error {
+	result := make([]error, 0)
+	for _, si := range schemas {
+		sMap, ok := si.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		sName, _ := sMap[NAME].(string)
+		s := c.schemas[sName]
+		if s == nil {
+			continue
+		}
+		for _, ti := range getTables(sMap) {
+			tMap, ok := ti.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			tName, _ := tMap[NAME].(string)
+			t := s.tables[tName]
+			if t == nil {
+				continue
+			}
+			if enabled, ok := tMap[ENABLED]; ok && getBoolValue(enabled) != t.enabled && !t.isPatchAllowed() {
+				result = append(result, fmt.Errorf(
+					"Table `%v` of schema `%v` is locked, its `enabled` value can't be changed to `%v`. Reason: %v.",
+					tName, sName, getBoolValue(enabled), t.getLockReason()))
+			}
+			for _, ci := range getColumns(tMap) {
+				cMap, ok := ci.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				cName, _ := cMap[NAME].(string)
+				result = append(result, t.checkConfiguredColumn(sName, cName, cMap)...)
+			}
+		}
+	}
+	if len(rules) > 0 {
+		local := SchemaConfig{}
+		local.ReadFromRawSourceData(schemas, ALLOW_ALL)
+		local.SetRules(rules)
+		result = append(result, c.checkRulesOnPrimaryKeys(local)...)
+	}
+	return sortedErrors(result)
+}
+
+func (t *_table) checkConfiguredColumn(sName, cName string, cMap map[string]interface{}) []error {
+	result := make([]error, 0)
+	c := t.columns[cName]
+	isPrimaryKey := c != nil && c.isPrimaryKey != nil && *c.isPrimaryKey
+	if value, ok := cMap[IS_PRIMARY_KEY]; ok && getBoolValue(value) {
+		isPrimaryKey = true
+	}
+
+	if enabled, ok := cMap[ENABLED]; ok {
+		if c != nil && getBoolValue(enabled) != c.enabled && !c.isPatchAllowed() {
+			result = append(result, fmt.Errorf(
+				"Column `%v` in table `%v` of schema `%v` is locked, its `enabled` value can't be changed to `%v`. Reason: %v.",
+				cName, t.name, sName, getBoolValue(enabled), c.getLockReason()))
+		} else if isPrimaryKey && !getBoolValue(enabled) && (c == nil || c.enabled) {
+			result = append(result, fmt.Errorf(
+				"Column `%v` in table `%v` of schema `%v` is a primary key column, it can't be disabled: the table sync relies on it.",
+				cName, t.name, sName))
+		}
+	}
+	if hashed, ok := cMap[HASHED]; ok && isPrimaryKey && getBoolValue(hashed) && (c == nil || !c.isHashed()) {
+		result = append(result, fmt.Errorf(
+			"Column `%v` in table `%v` of schema `%v` is a primary key column, it can't be hashed: the table sync relies on it.",
+			cName, t.name, sName))
+	}
+	return result
+}
+
+func (c *_column) isHashed() bool {
+	return c.hashed != nil && *c.hashed
+}
+
+// checkRulesOnPrimaryKeys reports rules that would disable or hash upstream primary key columns.
+func (c SchemaConfig) checkRulesOnPrimaryKeys(local SchemaConfig) []error {
+	result := make([]error, 0)
+	effects, err := c.ResolveRules(local)
+	if err != nil {
+		// invalid rules are reported by config validation
+		return result
+	}
+	for sName, s := range c.schemas {
+		for tName, t := range s.tables {
+			for cName, col := range t.columns {
+				effect, ok := effects[rulePath(sName, tName, cName)]
+				if !ok || col.isPrimaryKey == nil || !*col.isPrimaryKey {
+					continue
+				}
+				if effect.Enabled != nil && !*effect.Enabled && col.enabled {
+					result = append(result, fmt.Errorf(
+						"Rules disable column `%v` in table `%v` of schema `%v`. It is a primary key column, it can't be disabled: the table sync relies on it.",
+						cName, tName, sName))
+				}
+				if effect.Hashed != nil && *effect.Hashed && !col.isHashed() {
+					result = append(result, fmt.Errorf(
+						"Rules hash column `%v` in table `%v` of schema `%v`. It is a primary key column, it can't be hashed: the table sync relies on it.",
+						cName, tName, sName))
+				}
+			}
+		}
+	}
+	return result
+}
+
+func sortedErrors(errors []error) []error {
+	sort.Slice(errors, func(i, j int) bool { return errors[i].Error() < errors[j].Error() })
+	return errors
+}
@@ -0,0 +1,90 @@
+package schema
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fivetran/go-fivetran/connections"
+)
+
+func lockedUpstreamConfig() SchemaConfig {
+	locked := upstreamTable(true, nil)
+	locked.EnabledPatchSettings.Allowed = boolPtr(false)
+	locked.EnabledPatchSettings.Reason = stringPtr("System table")
+
+	users := upstreamTable(true, map[string]bool{"id": true, "email": true, "secret": true})
+	users.Columns["id"].IsPrimaryKey = boolPtr(true)
+	users.Columns["email"].IsPrimaryKey = boolPtr(true)
+	users.Columns["email"].Hashed = boolPtr(true)
+	users.Columns["secret"].EnabledPatchSettings.Allowed = boolPtr(false)
+
+	return upstreamConfig(map[string]*connections.ConnectionSchemaConfigTableResponse{
+		"locked": locked,
+		"users":  users,
+		"orders": upstreamTable(true, nil),
+	})
+}
+
+func configuredTable(name string, values map[string]interface{}, columns ...map[string]interface{}) map[string]interface{} {
+	result := map[string]interface{}{NAME: name}
+	for k, v := range values {
+		result[k] = v
+	}
+	columnList := []interface{}{}
+	for _, c := range columns {
+		columnList = append(columnList, c)
+	}
+	result[COLUMN] = columnList
+	return result
+}
+
+func TestCheckConfiguredElementsReportsLockedAndPrimaryKeyChanges(t *testing.T) {
+	schemas := []interface{}{map[string]interface{}{
+		NAME: "public",
+		TABLE: []interface{}{
+			configuredTable("locked", map[string]interface{}{ENABLED: false}),
+			configuredTable("orders", map[string]interface{}{ENABLED: false}),
+			configuredTable("users", nil,
+				map[string]interface{}{NAME: "id", ENABLED: false, HASHED: true},
+				map[string]interface{}{NAME: "email", HASHED: true},
+				map[string]interface{}{NAME: "secret", ENABLED: false},
+			),
+		},
+	}}
+
+	errors := lockedUpstreamConfig().CheckConfiguredElements(schemas, nil)
+	expected := []string{
+		"Column `id` in table `users` of schema `public` is a primary key column, it can't be disabled",
+		"Column `id` in table `users` of schema `public` is a primary key column, it can't be hashed",
+		"Column `secret` in table `users` of schema `public` is locked",
+		"Table `locked` of schema `public` is locked, its `enabled` value can't be changed to `false`. Reason: code: unknown | reason: System table.",
+	}
+	if len(errors) != len(expected) {
+		t.Fatalf("errors = %v, want %v", errors, len(expected))
+	}
+	for i, e := range expected {
+		if !strings.HasPrefix(errors[i].Error(), e) {
+			t.Errorf("error %v = %q, want prefix %q", i, errors[i], e)
+		}
+	}
+}
+
+func TestCheckConfiguredElementsReportsRulesOnPrimaryKeys(t *testing.T) {
+	rules := []Rule{
+		{MatchType: REGEX, Column: "^(id|email)$", Hashed: boolPtr(true)},
+		{MatchType: REGEX, Table: "^locked$", Enabled: boolPtr(false)},
+	}
+
+	errors := lockedUpstreamConfig().CheckConfiguredElements([]interface{}{}, rules)
+	if len(errors) != 1 || !strings.HasPrefix(errors[0].Error(), "Rules hash column `id` in table `users` of schema `public`") {
+		t.Errorf("errors = %v, want a single error for column `id`", errors)
+	}
+
+	explicit := []interface{}{map[string]interface{}{
+		NAME:  "public",
+		TABLE: []interface{}{configuredTable("users", nil, map[string]interface{}{NAME: "id", HASHED: false})},
+	}}
+	if errors := lockedUpstreamConfig().CheckConfiguredElements(explicit, rules); len(errors) != 0 {
+		t.Errorf("explicitly configured column should take precedence over rules: %v", errors)
+	}
+}
@@ -0,0 +1,383 @@
+package schema
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/fivetran/go-fivetran/connections"
+)
+
+// Rule is a pattern based rule applied to the upstream schemas, tables or columns that are not configured explicitly.
+// The rule level is defined by the most specific pattern set: `Column`, `Table` or `Schema`. Empty patterns match any name.
+type Rule struct {
+	MatchType string
+	Schema    string
+	Table     string
+	Column    string
+	Enabled   *bool
+	Hashed    *bool
+	SyncMode  *string
+}
+
+// RuleEffect is the resolved effect of the rules on a single schema, table or column.
+type RuleEffect struct {
+	Enabled  *bool
+	Hashed   *bool
+	SyncMode *string
+}
+
+const (
+	ruleLevelSchema = iota
+	ruleLevelTable
+	ruleLevelColumn
+)
+
+type _rule struct {
+	Rule
+	level  int
+	schema func(string) bool
+	table  func(string) bool
+	column func(string) bool
+}
+
+func (r Rule) level() int {
+	if r.Column != "" {
+		return ruleLevelColumn
+	}
+	if r.Table != "" {
+		return ruleLevelTable
+	}
+	return ruleLevelSchema
+}
+
+// Validate checks that rule patterns compile and that the rule effects are applicable to the rule level.
+func (r Rule) Validate() error {
+	if r.Enabled == nil && r.Hashed == nil && r.SyncMode == nil {
+		return fmt.Errorf("Rule should define at least one of `enabled`, `hashed` or `sync_mode`.")
+	}
+	if r.Hashed != nil && r.level() != ruleLevelColumn {
+		return fmt.Errorf("Rule with `hashed` should define `column` pattern.")
+	}
+	if r.SyncMode != nil && r.level() != ruleLevelTable {
+		return fmt.Errorf("Rule with `sync_mode` should define `table` pattern and no `column` pattern.")
+	}
+	_, err := r.compile()
+	return err
+}
+
+func (r Rule) compile() (*_rule, error) {
+	result := &_rule{Rule: r, level: r.level()}
+	var err error
+	if result.schema, err = compilePattern(r.MatchType, r.Schema); err != nil {
+		return nil, err
+	}
+	if result.table, err = compilePattern(r.MatchType, r.Table); err != nil {
+		return nil, err
+	}
+	if result.column, err = compilePattern(r.MatchType, r.Column); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func compilePattern(matchType, pattern string) (func(string) bool, error) {
+	if pattern == "" {
+		return func(string) bool { return true }, nil
+	}
+	if matchType == GLOB {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("Invalid glob pattern `%v`: %v.", pattern, err)
+		}
+		return func(name string) bool {
+			matched, _ := path.Match(pattern, name)
+			return matched
+		}, nil
+	}
+	// regular expressions match whole names like globs do, `.*_archive` doesn't match `orders_archive_v2`
+	re, err := regexp.Compile("^(?:" + pattern + ")$")
+	if err != nil {
+		return nil, fmt.Errorf("Invalid regular expression `%v`: %v.", pattern, err)
+	}
+	return re.MatchString, nil
+}
+
+func compileRules(rules []Rule) ([]*_rule, error) {
+	result := make([]*_rule, 0, len(rules))
+	for i, r := range rules {
+		compiled, err := r.compile()
+		if err != nil {
+			return nil, fmt.Errorf("rule %v: %v", i, err)
+		}
+		result = append(result, compiled)
+	}
+	return result, nil
+}
+
+func rulePath(names ...string) string {
+	return strings.Join(names, ".")
+}
+
+// resolveRuleEffect applies matching rules in order, so later rules take precedence over earlier ones.
+// `enabled` is not resolved for locked elements: rules are broad and shouldn't fail on system-enabled items.
+func resolveRuleEffect(rules []*_rule, level int, element _element, sName, tName, cName string) (RuleEffect, bool) {
+	result := RuleEffect{}
+	for _, r := range rules {
+		if r.level != level || !r.schema(sName) || !r.table(tName) || !r.column(cName) {
+			continue
+		}
+		if r.Enabled != nil && element.isPatchAllowed() {
+			result.Enabled = r.Enabled
+		}
+		if r.Hashed != nil {
+			result.Hashed = r.Hashed
+		}
+		if r.SyncMode != nil {
+			result.SyncMode = r.SyncMode
+		}
+	}
+	return result, result.Enabled != nil || result.Hashed != nil || result.SyncMode != nil
+}
+
+// ResolveRules returns the rule effects on upstream elements that are not configured explicitly in local config.
+// Keys are element paths in `schema`, `schema.table` or `schema.table.column` form.
+func (c SchemaConfig) ResolveRules(local SchemaConfig) (map[string]RuleEffect, error) {
+	result := make(map[string]RuleEffect)
+	if len(local.rules) == 0 {
+		return result, nil
+	}
+	rules, err := compileRules(local.rules)
+	if err != nil {
+		return nil, err
+	}
+	for sName, s := range c.schemas {
+		ls := local.schemas[sName]
+		if ls == nil {
+			if effect, ok := resolveRuleEffect(rules, ruleLevelSchema, s._element, sName, "", ""); ok {
+				result[rulePath(sName)] = effect
+			}
+		}
+		for tName, t := range s.tables {
+			var lt *_table
+			if ls != nil {
+				lt = ls.tables[tName]
+			}
+			if lt == nil {
+				if effect, ok := resolveRuleEffect(rules, ruleLevelTable, t._element, sName, tName, ""); ok {
+					result[rulePath(sName, tName)] = effect
+				}
+			}
+			for cName, col := range t.columns {
+				if lt != nil {
+					if _, ok := lt.columns[cName]; ok {
+						continue
+					}
+				}
+				if effect, ok := resolveRuleEffect(rules, ruleLevelColumn, col._element, sName, tName, cName); ok {
+					result[rulePath(sName, tName, cName)] = effect
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+// SetRules defines ordered rules evaluated against upstream config in Override.
+func (c *SchemaConfig) SetRules(rules []Rule) {
+	c.rules = rules
+}
+
+// applyRules returns a copy of local config extended with elements resolved from rules.
+// Elements without rule effect keep following schema change handling policy.
+func (c SchemaConfig) applyRules(local *SchemaConfig, sch string) (*SchemaConfig, error) {
+	if local == nil || len(local.rules) == 0 {
+		return local, nil
+	}
+	effects, err := c.ResolveRules(*local)
+	if err != nil || len(effects) == 0 {
+		return local, err
+	}
+
+	result := &SchemaConfig{schemas: make(map[string]*_schema), rules: local.rules}
+	for k, v := range local.schemas {
+		result.schemas[k] = v
+	}
+
+	for sName, s := range c.schemas {
+		ls := local.schemas[sName]
+		tables := make(map[string]*_table)
+		tablesEnabled := false
+		for tName, t := range s.tables {
+			var lt *_table
+			if ls != nil {
+				lt = ls.tables[tName]
+			}
+			columns := make(map[string]*_column)
+			for cName, col := range t.columns {
+				if effect, ok := effects[rulePath(sName, tName, cName)]; ok {
+					columns[cName] = effect.toColumn(col, sch)
+				}
+			}
+			tableEffect, tableMatched := effects[rulePath(sName, tName)]
+			if lt != nil {
+				if len(columns) > 0 {
+					tables[tName] = lt.withColumns(columns)
+				}
+			} else if tableMatched || len(columns) > 0 {
+				table := tableEffect.toTable(t, sch)
+				table.columns = columns
+				tables[tName] = table
+				tablesEnabled = tablesEnabled || (tableEffect.Enabled != nil && *tableEffect.Enabled)
+			}
+		}
+		schemaEffect, schemaMatched := effects[rulePath(sName)]
+		if ls != nil {
+			if len(tables) > 0 {
+				result.schemas[sName] = ls.withTables(tables)
+			}
+		} else if schemaMatched || len(tables) > 0 {
+			schema := schemaEffect.toSchema(sName, sch, tablesEnabled)
+			schema.tables = tables
+			result.schemas[sName] = schema
+		}
+	}
+	return result, nil
+}
+
+// markRuleManaged flags upstream elements aligned by rules, so they are not reported in state as inconsistent with the policy.
+func (c SchemaConfig) markRuleManaged(effects map[string]RuleEffect) {
+	for sName, s := range c.schemas {
+		for tName, t := range s.tables {
+			if _, ok := effects[rulePath(sName, tName)]; ok {
+				t.ruleManaged = true
+				s.ruleManaged = true
+			}
+			for cName, col := range t.columns {
+				if _, ok := effects[rulePath(sName, tName, cName)]; ok {
+					col.ruleManaged = true
+					s.ruleManaged = true
+				}
+			}
+		}
+		if _, ok := effects[rulePath(sName)]; ok {
+			s.ruleManaged = true
+		}
+	}
+}
+
+// ruleColumnRequests returns requests to fetch columns of upstream tables targeted by column rules:
+// schema details contain only columns that were configured before.
+func (c SchemaConfig) ruleColumnRequests(schemas map[string]*connections.ConnectionSchemaConfigSchemaResponse) ([]_columnRequest, error) {
+	rules, err := compileRules(c.rules)
+	if err != nil {
+		return nil, err
+	}
+	columnRules := make([]*_rule, 0)
+	for _, r := range rules {
+		if r.level == ruleLevelColumn {
+			columnRules = append(columnRules, r)
+		}
+	}
+	requests := make([]_columnRequest, 0)
+	if len(columnRules) == 0 {
+		return requests, nil
+	}
+	for sName, s := range schemas {
+		for tName, t := range s.Tables {
+			if t.SupportsColumnsConfig != nil && !*t.SupportsColumnsConfig {
+				continue
+			}
+			if matchesAnyTable(columnRules, sName, tName) {
+				requests = append(requests, _columnRequest{_tableKey: _tableKey{schema: sName, table: tName}})
+			}
+		}
+	}
+	return requests, nil
+}
+
+// mergeColumnRequests appends rule requests for tables that are not requested yet.
+func mergeColumnRequests(requests, ruleRequests []_columnRequest) []_columnRequest {
+	requested := make(map[_tableKey]bool)
+	for _, r := range requests {
+		requested[r._tableKey] = true
+	}
+	for _, r := range ruleRequests {
+		if !requested[r._tableKey] {
+			requests = append(requests, r)
+			requested[r._tableKey] = true
+		}
+	}
+	return requests
+}
+
+func matchesAnyTable(rules []*_rule, sName, tName string) bool {
+	for _, r := range rules {
+		if r.schema(sName) && r.table(tName) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e RuleEffect) toSchema(name, sch string, tablesEnabled bool) *_schema {
+	result := &_schema{}
+	result.name = name
+	result.enabled = tablesEnabled || sch == ALLOW_ALL
+	if e.Enabled != nil {
+		result.enabled = *e.Enabled
+	}
+	return result
+}
+
+func (e RuleEffect) toTable(upstream *_table, sch string) *_table {
+	result := &_table{}
+	result.name = upstream.name
+	result.enabled = sch == ALLOW_ALL
+	if !upstream.isPatchAllowed() {
+		result.enabled = upstream.enabled
+	}
+	if e.Enabled != nil {
+		result.enabled = *e.Enabled
+	}
+	result.syncMode = e.SyncMode
+	return result
+}
+
+func (e RuleEffect) toColumn(upstream *_column, sch string) *_column {
+	result := &_column{}
+	result.name = upstream.name
+	result.enabled = sch != BLOCK_ALL
+	if !upstream.isPatchAllowed() {
+		result.enabled = upstream.enabled
+	}
+	if e.Enabled != nil {
+		result.enabled = *e.Enabled
+	}
+	result.hashed = e.Hashed
+	return result
+}
+
+func (s *_schema) withTables(tables map[string]*_table) *_schema {
+	result := *s
+	result.tables = make(map[string]*_table)
+	for k, v := range s.tables {
+		result.tables[k] = v
+	}
+	for k, v := range tables {
+		result.tables[k] = v
+	}
+	return &result
+}
+
+func (t *_table) withColumns(columns map[string]*_column) *_table {
+	result := *t
+	result.columns = make(map[string]*_column)
+	for k, v := range t.columns {
+		result.columns[k] = v
+	}
+	for k, v := range columns {
+		result.columns[k] = v
+	}
+	return &result
+}
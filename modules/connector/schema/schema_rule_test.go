@@ -0,0 +1,232 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/fivetran/go-fivetran/connections"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func boolPtr(v bool) *bool {
+	return &v
+}
+
+func stringPtr(v string) *string {
+	return &v
+}
+
+func upstreamTable(enabled bool, columns map[string]bool) *connections.ConnectionSchemaConfigTableResponse {
+	result := &connections.ConnectionSchemaConfigTableResponse{
+		Enabled: boolPtr(enabled),
+		Columns: map[string]*connections.ConnectionSchemaConfigColumnResponse{},
+	}
+	for name, columnEnabled := range columns {
+		result.Columns[name] = &connections.ConnectionSchemaConfigColumnResponse{
+			Enabled: boolPtr(columnEnabled),
+			Hashed:  boolPtr(false),
+		}
+	}
+	return result
+}
+
+func upstreamConfig(tables map[string]*connections.ConnectionSchemaConfigTableResponse) SchemaConfig {
+	response := connections.ConnectionSchemaDetailsResponse{}
+	response.Data.SchemaChangeHandling = ALLOW_ALL
+	response.Data.Schemas = map[string]*connections.ConnectionSchemaConfigSchemaResponse{
+		"public": {
+			Enabled: boolPtr(true),
+			Tables:  tables,
+		},
+	}
+	result := SchemaConfig{}
+	result.ReadFromResponse(response)
+	return result
+}
+
+func localConfig(schemas []interface{}, rules ...Rule) SchemaConfig {
+	result := SchemaConfig{}
+	result.ReadFromRawSourceData(schemas, ALLOW_ALL)
+	result.SetRules(rules)
+	return result
+}
+
+func TestOverrideAppliesRulesInOrder(t *testing.T) {
+	upstream := upstreamConfig(map[string]*connections.ConnectionSchemaConfigTableResponse{
+		"stg_orders":         upstreamTable(false, nil),
+		"stg_orders_archive": upstreamTable(true, nil),
+		"users_archive":      upstreamTable(true, nil),
+		"audit_log":          upstreamTable(true, nil),
+		"users":              upstreamTable(true, map[string]bool{"Email": true, "id": true}),
+	})
+	local := localConfig(nil,
+		Rule{MatchType: REGEX, Table: "^stg_.*", Enabled: boolPtr(true)},
+		Rule{MatchType: REGEX, Table: ".*_archive", Enabled: boolPtr(false)},
+		Rule{MatchType: REGEX, Column: "(?i)email|phone", Hashed: boolPtr(true)},
+		Rule{MatchType: REGEX, Table: "audit_.*", SyncMode: stringPtr(HISTORY)},
+	)
+
+	if err := upstream.Override(&local, ALLOW_ALL); err != nil {
+		t.Fatalf("Override: %v", err)
+	}
+
+	tables := upstream.schemas["public"].tables
+	if !tables["stg_orders"].enabled || !tables["stg_orders"].updated {
+		t.Errorf("stg_orders should be enabled by rule")
+	}
+	if tables["stg_orders_archive"].enabled || tables["users_archive"].enabled {
+		t.Errorf("archive tables should be disabled by the later rule")
+	}
+	if tables["audit_log"].syncMode == nil || *tables["audit_log"].syncMode != HISTORY {
+		t.Errorf("audit_log sync_mode = %v, want HISTORY", tables["audit_log"].syncMode)
+	}
+	if c := tables["users"].columns["Email"]; c.hashed == nil || !*c.hashed || !c.updated {
+		t.Errorf("Email column should be hashed by rule")
+	}
+	if c := tables["users"].columns["id"]; c.updated {
+		t.Errorf("id column should not be changed")
+	}
+	if tables["users"].updated != true || !upstream.HasUpdates() {
+		t.Errorf("expected updates in schema config")
+	}
+}
+
+func TestOverrideExplicitEntriesTakePrecedenceOverRules(t *testing.T) {
+	upstream := upstreamConfig(map[string]*connections.ConnectionSchemaConfigTableResponse{
+		"stg_orders": upstreamTable(true, nil),
+		"stg_users":  upstreamTable(true, nil),
+	})
+	local := localConfig([]interface{}{
+		map[string]interface{}{
+			NAME: "public",
+			TABLE: []interface{}{
+				map[string]interface{}{NAME: "stg_users", ENABLED: true},
+			},
+		},
+	}, Rule{MatchType: GLOB, Table: "stg_*", Enabled: boolPtr(false)})
+
+	if err := upstream.Override(&local, ALLOW_ALL); err != nil {
+		t.Fatalf("Override: %v", err)
+	}
+
+	tables := upstream.schemas["public"].tables
+	if tables["stg_orders"].enabled {
+		t.Errorf("stg_orders should be disabled by glob rule")
+	}
+	if !tables["stg_users"].enabled || tables["stg_users"].updated {
+		t.Errorf("stg_users is configured explicitly and should stay enabled")
+	}
+	if len(local.schemas["public"].tables) != 1 {
+		t.Errorf("Override should not change local config")
+	}
+}
+
+func TestOverrideRulesSkipLockedTables(t *testing.T) {
+	locked := upstreamTable(true, nil)
+	locked.EnabledPatchSettings.Allowed = boolPtr(false)
+	upstream := upstreamConfig(map[string]*connections.ConnectionSchemaConfigTableResponse{
+		"sys_archive": locked,
+	})
+	local := localConfig(nil, Rule{MatchType: REGEX, Table: ".*_archive", Enabled: boolPtr(false)})
+
+	if err := upstream.Override(&local, ALLOW_ALL); err != nil {
+		t.Fatalf("Override should skip locked tables, got: %v", err)
+	}
+	if !upstream.schemas["public"].tables["sys_archive"].enabled {
+		t.Errorf("locked table should stay enabled")
+	}
+}
+
+func TestGetSchemasOmitsRuleManagedElements(t *testing.T) {
+	upstream := upstreamConfig(map[string]*connections.ConnectionSchemaConfigTableResponse{
+		"orders_archive": upstreamTable(false, nil),
+		"orders_backup":  upstreamTable(false, nil),
+	})
+	local := localConfig(nil, Rule{MatchType: REGEX, Table: ".*_archive", Enabled: boolPtr(false)})
+
+	diags := diag.Diagnostics{}
+	schemas := upstream.GetSchemas(ALLOW_ALL, local, false, &diags)
+	if len(schemas) != 1 {
+		t.Fatalf("expected only the public schema with a drifted table, got %v", schemas)
+	}
+	tables := schemas[0].(map[string]interface{})[TABLE].([]interface{})
+	if len(tables) != 1 || tables[0].(map[string]interface{})[NAME] != "orders_backup" {
+		t.Errorf("tables = %v, want only orders_backup", tables)
+	}
+}
+
+func TestResolveRules(t *testing.T) {
+	upstream := upstreamConfig(map[string]*connections.ConnectionSchemaConfigTableResponse{
+		"audit_log": upstreamTable(true, map[string]bool{"phone": true}),
+		"orders":    upstreamTable(true, nil),
+	})
+	local := localConfig(nil,
+		Rule{MatchType: REGEX, Table: "audit_.*", SyncMode: stringPtr(HISTORY)},
+		Rule{MatchType: REGEX, Column: "phone", Hashed: boolPtr(true)},
+	)
+
+	effects, err := upstream.ResolveRules(local)
+	if err != nil {
+		t.Fatalf("ResolveRules: %v", err)
+	}
+	if len(effects) != 2 {
+		t.Fatalf("effects = %v, want 2 entries", effects)
+	}
+	if e := effects["public.audit_log"]; e.SyncMode == nil || *e.SyncMode != HISTORY || e.Enabled != nil {
+		t.Errorf("public.audit_log effect = %+v", e)
+	}
+	if e := effects["public.audit_log.phone"]; e.Hashed == nil || !*e.Hashed {
+		t.Errorf("public.audit_log.phone effect = %+v", e)
+	}
+}
+
+func TestRuleValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    Rule
+		wantErr bool
+	}{
+		{"table enabled", Rule{MatchType: REGEX, Table: "^stg_.*", Enabled: boolPtr(true)}, false},
+		{"schema enabled", Rule{MatchType: GLOB, Schema: "tmp_*", Enabled: boolPtr(false)}, false},
+		{"no effect", Rule{MatchType: REGEX, Table: "x"}, true},
+		{"hashed table", Rule{MatchType: REGEX, Table: "x", Hashed: boolPtr(true)}, true},
+		{"sync_mode column", Rule{MatchType: REGEX, Table: "x", Column: "y", SyncMode: stringPtr(LIVE)}, true},
+		{"invalid regex", Rule{MatchType: REGEX, Table: "(", Enabled: boolPtr(true)}, true},
+		{"invalid glob", Rule{MatchType: GLOB, Table: "[", Enabled: boolPtr(true)}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.rule.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRulePatternsMatchWholeNames(t *testing.T) {
+	tests := []struct {
+		matchType string
+		pattern   string
+		name      string
+		want      bool
+	}{
+		{REGEX, ".*_archive", "orders_archive", true},
+		{REGEX, ".*_archive", "orders_archive_v2", false},
+		{REGEX, "(?i)email|phone", "Phone", true},
+		{REGEX, "(?i)email|phone", "phone_verified_at", false},
+		{REGEX, "(?i)email|phone", "work_email", false},
+		{REGEX, "(?i).*(email|phone).*", "phone_verified_at", true},
+		{REGEX, "^stg_.*$", "stg_orders", true},
+		{GLOB, "*_archive", "orders_archive_v2", false},
+		{GLOB, "*_archive*", "orders_archive_v2", true},
+		{REGEX, "", "anything", true},
+	}
+	for _, tt := range tests {
+		match, err := compilePattern(tt.matchType, tt.pattern)
+		if err != nil {
+			t.Fatalf("compilePattern(%v, %v): %v", tt.matchType, tt.pattern, err)
+		}
+		if got := match(tt.name); got != tt.want {
+			t.Errorf("%v pattern `%v` matches %v = %v, want %v", tt.matchType, tt.pattern, tt.name, got, tt.want)
+		}
+	}
+}
@@ -1,7 +1,6 @@
 package schema
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/fivetran/go-fivetran"
@@ -16,24 +15,26 @@ type _schema struct {
 }
 
 func (s _schema) validateTables(
-	connectorId, sName string,
+	sName string,
 	responseSchema *connections.ConnectionSchemaConfigSchemaResponse,
-	client fivetran.Client,
-	ctx context.Context,
-	validateColumns bool) (error, bool) {
+	validateColumns bool) ([]_columnRequest, error, bool) {
+	requests := make([]_columnRequest, 0)
 	for tName, table := range s.tables {
 		if responseTable, ok := responseSchema.Tables[tName]; ok {
 			if validateColumns {
-				err := table.validateColumns(connectorId, sName, tName, responseTable, client, ctx)
+				request, err := table.validateColumns(sName, tName, responseTable)
 				if err != nil {
-					return err, false
+					return nil, err, false
+				}
+				if request != nil {
+					requests = append(requests, *request)
 				}
 			}
 		} else {
-			return fmt.Errorf("Table with name `%s` not found in source schema `%s`.", tName, sName), true
+			return nil, fmt.Errorf("Table with name `%s` not found in source schema `%s`.", tName, sName), true
 		}
 	}
-	return nil, false
+	return requests, nil, false
 }
 
 func (s _schema) prepareRequest() *connections.ConnectionSchemaConfigSchema {
@@ -194,6 +195,6 @@ func (s _schema) toStateObject(sch string, local *_schema, isImporting bool, dia
 	}
 	result[TABLE] = tables
 	// schema has been configured locally OR has tables to include (only if schema is enabled) OR schema inconsistent by policy
-	include := local != nil || (len(tables) > 0 && s.enabled) || s.enabled != (sch == ALLOW_ALL) || isImporting
+	include := local != nil || (len(tables) > 0 && s.enabled) || (s.enabled != (sch == ALLOW_ALL) && !s.ruleManaged) || isImporting
 	return result, include
 }
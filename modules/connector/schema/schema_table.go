@@ -1,7 +1,6 @@
 package schema
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/fivetran/go-fivetran"
@@ -16,47 +15,29 @@ type _table struct {
 	columns  map[string]*_column
 }
 
+// validateColumns returns the request to fetch table columns when upstream config doesn't contain all configured columns.
+// Schema details contain only columns that were configured before, so the whole column list is fetched.
 func (t _table) validateColumns(
-	connectorId, sName, tName string,
-	responseTable *connections.ConnectionSchemaConfigTableResponse,
-	client fivetran.Client,
-	ctx context.Context) error {
-	if len(t.columns) > 0 {
-		if responseTable.SupportsColumnsConfig != nil && !*responseTable.SupportsColumnsConfig {
-			return fmt.Errorf("Table `%v` of schema `%s` doesn't support columns configuration.", tName, sName)
-		}
-		columnsWereFetched := false
-		if len(responseTable.Columns) == 0 {
-			response, err := client.NewConnectionColumnConfigListService().ConnectionId(connectorId).Schema(sName).Table(tName).Do(ctx)
-			if err != nil {
-				return fmt.Errorf("Error while retrieving columns config for table `%s` of schema `%s. Error: %v; Code: `%v`.",
-					tName, sName, err, response.Code)
-			}
-			responseTable.Columns = response.Data.Columns
-			columnsWereFetched = true
-		} else {
-			for cName, _ := range t.columns {
-				if _, ok := responseTable.Columns[cName]; !ok {
-					if !columnsWereFetched {
-						response, err := client.NewConnectionColumnConfigListService().ConnectionId(connectorId).Schema(sName).Table(tName).Do(ctx)
-						if err != nil {
-							return fmt.Errorf("Error while retrieving columns config for table `%s` of schema `%s. Error: %v; Code: `%v`.",
-								tName, sName, err, response.Code)
-						}
-						responseTable.Columns = response.Data.Columns
-						columnsWereFetched = true
-						if _, ok := responseTable.Columns[cName]; !ok {
-							return fmt.Errorf("Column `%v` of table with name `%s` not found in source schema `%s`.", cName, tName, sName)
-						}
-					} else {
-						return fmt.Errorf("Column `%v` of table with name `%s` not found in source schema `%s`.", cName, tName, sName)
-					}
-
-				}
-			}
+	sName, tName string,
+	responseTable *connections.ConnectionSchemaConfigTableResponse) (*_columnRequest, error) {
+	if len(t.columns) == 0 {
+		return nil, nil
+	}
+	if responseTable.SupportsColumnsConfig != nil && !*responseTable.SupportsColumnsConfig {
+		return nil, fmt.Errorf("Table `%v` of schema `%s` doesn't support columns configuration.", tName, sName)
+	}
+	request := &_columnRequest{_tableKey: _tableKey{schema: sName, table: tName}}
+	fetchRequired := len(responseTable.Columns) == 0
+	for cName := range t.columns {
+		request.required = append(request.required, cName)
+		if _, ok := responseTable.Columns[cName]; !ok {
+			fetchRequired = true
 		}
 	}
-	return nil
+	if !fetchRequired {
+		return nil, nil
+	}
+	return request, nil
 }
 
 func (t *_table) setSyncMode(value *string) {
@@ -132,6 +113,8 @@ func (t *_table) override(local *_table, sch string) error {
 					t.columns[lcName] = lc
 					t.columns[lcName].updated = true
 					t.columns[lcName].enabledPatched = true
+					// upstream doesn't return columns with default settings, so only hashing them is a change
+					t.columns[lcName].hashedPatched = lc.hashed != nil && *lc.hashed
 					t.updated = true
 				}
 			}
@@ -291,7 +274,7 @@ func (t _table) toStateObject(sch string, local *_table, diag *diag.Diagnostics,
 	}
 
 	// table has been configured locally OR has columns to include OR table inconsistent by policy (patch allowed)
-	include := local != nil || len(columns) > 0 || (t.enabled != (sch == ALLOW_ALL) && t.isPatchAllowed()) || isImporting
+	include := local != nil || len(columns) > 0 || (t.enabled != (sch == ALLOW_ALL) && t.isPatchAllowed() && !t.ruleManaged) || isImporting
 
 	return result, include
 }
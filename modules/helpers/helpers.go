@@ -6,6 +6,7 @@ import (
 	"hash/fnv"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -257,6 +258,55 @@ func ContextDelay(ctx context.Context, d time.Duration) error {
 	return nil
 }
 
+// ForEachBounded calls do for the indexes 0..n-1 with at most `concurrency` calls running at the same time.
+// The first failed call cancels the context passed to the running calls, the calls that haven't started yet are skipped
+// and its error is returned. The error of the parent context is returned if it's cancelled.
+func ForEachBounded(ctx context.Context, concurrency, n int, do func(ctx context.Context, i int) error) error {
+	if n == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		once     sync.Once
+		firstErr error
+		wg       sync.WaitGroup
+	)
+	indexes := make(chan int)
+	for w := 0; w < min(max(concurrency, 1), n); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if err := do(ctx, i); err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+
+send:
+	for i := 0; i < n; i++ {
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			break send
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
 // intersection accepts two slices of same type as arguments and returns three slices:
 // uniques for the first argument, intersection and uniques for second argument
 // results are collections of distinct elements (sets)